@@ -170,6 +170,9 @@ func IsValidAttributeValueDoubleQuoted(val string) bool {
 // "&ambiguous;" is ambiguous, but "&amp;" is not because "&amp;" is a valid
 // reference. See also IsCharacterReferenceName and IsCharacterReference.
 //
+// Legacy references without a semicolon, like "&copy 2014", are not ambiguous
+// ampersands. Use HasLegacyCharacterReference to find those.
+//
 func HasAmbiguousAmpersand(val string) bool {
 
 	scanner := NamedReferenceScanner{val, -1}
@@ -186,6 +189,27 @@ func HasAmbiguousAmpersand(val string) bool {
 	}
 }
 
+// HasLegacyCharacterReference returns true if the argument contains a legacy
+// character reference without its semicolon, like "&copy" in "&copy 2014" or
+// "&not" in "&notit;". Browsers decode these in text even though the semicolon
+// is missing. See also IsLegacyCharacterReferenceName.
+//
+func HasLegacyCharacterReference(val string) bool {
+
+	scanner := NamedReferenceScanner{val, -1}
+
+	for {
+		name, idx := (&scanner).NextLegacy()
+		if idx == -1 {
+			return false
+		}
+
+		if !scanner.isTerminated(name, idx) {
+			return true
+		}
+	}
+}
+
 // INTERNAL USE ONLY. NO API GUARANTEES.
 //
 // NamedReferenceScanner is a utility for scanning through strings and looking
@@ -269,6 +293,78 @@ func (scanner *NamedReferenceScanner) Next() (name string, ampIndex int) {
 	return "", -1
 }
 
+// NextLegacy is like Next, except it also reports the legacy character
+// references that browsers decode without a trailing semicolon, using the
+// longest matching legacy name. For example, "&copy 2014" returns "copy", and
+// "&notit;" returns "not" because a browser reads it as "&not" followed by
+// "it;".
+//
+// To tell the results apart, check the byte following the name: a legacy
+// reference is not followed by a semicolon.
+//
+// Note: In attribute values, browsers do not decode a legacy reference that is
+// followed by "=" or an alphanumeric character. NextLegacy reports those, too.
+//
+func (scanner *NamedReferenceScanner) NextLegacy() (name string, ampIndex int) {
+
+	length := len(scanner.Value)
+
+	for i := scanner.LastIndex + 1; i < length; i++ {
+
+		if scanner.Value[i] != UnicodeAmpersand {
+			continue
+		}
+
+		// Find the run of alphanumeric characters after the ampersand.
+
+		j := i + 1
+		for j < length && isASCIIAlphanumeric(scanner.Value[j]) {
+			j++
+		}
+		run := scanner.Value[i+1 : j]
+
+		if len(run) == 0 {
+			continue
+		}
+
+		// A run followed by a semicolon is reported just like Next would,
+		// unless it isn't a valid name and a legacy name matches a prefix.
+
+		terminated := j < length && scanner.Value[j] == UnicodeSemicolon
+		if terminated && IsCharacterReferenceName(run) {
+			scanner.LastIndex = j
+			return run, i
+		}
+
+		if prefix := LegacyCharacterReferencePrefix(run); prefix != "" {
+			scanner.LastIndex = i + len(prefix)
+			return prefix, i
+		}
+
+		if terminated {
+			scanner.LastIndex = j
+			return run, i
+		}
+	}
+
+	// Didn't find anything.
+
+	scanner.LastIndex = length
+	return "", -1
+}
+
+// isTerminated returns true if the name returned by Next or NextLegacy at
+// ampIndex is followed by a semicolon.
+//
+func (scanner *NamedReferenceScanner) isTerminated(name string, ampIndex int) bool {
+	end := ampIndex + 1 + len(name)
+	return end < len(scanner.Value) && scanner.Value[end] == UnicodeSemicolon
+}
+
+func isASCIIAlphanumeric(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
 // Reset resets the scanner to the beginning of the Value string.
 //
 func (scanner *NamedReferenceScanner) Reset() {
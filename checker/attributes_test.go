@@ -277,6 +277,41 @@ func ExampleHasAmbiguousAmpersand() {
 	// true
 }
 
+func TestHasLegacyCharacterReference(t *testing.T) {
+
+	legacy := []string{
+		"&copy 2014",
+		"&copy2014",
+		"&notit;",
+		"&amp &amp;",
+		"x &lt",
+		"&amp; &gt",
+	}
+	casesShouldBeTrue(t, legacy, HasLegacyCharacterReference,
+		"Expected HasLegacyCharacterReference(%q) to be true, got false")
+
+	notLegacy := []string{
+		"",
+		"nothing here",
+		"&copy; 2014",
+		"&notin;",
+		"&hearts",
+		"&funky;",
+		"& that",
+		"&#169",
+	}
+	casesShouldBeFalse(t, notLegacy, HasLegacyCharacterReference,
+		"Expected HasLegacyCharacterReference(%q) to be false, got true")
+}
+
+func ExampleHasLegacyCharacterReference() {
+	fmt.Println(HasLegacyCharacterReference("&copy; 2014"))
+	fmt.Println(HasLegacyCharacterReference("&copy 2014"))
+	// Output:
+	// false
+	// true
+}
+
 func TestisUnicodeNonCharacter(t *testing.T) {
 
 	// From Wikipedia:
@@ -358,3 +393,35 @@ func TestNamedReferenceScanner_Next(t *testing.T) {
 		}
 	}
 }
+
+func TestNamedReferenceScanner_NextLegacy(t *testing.T) {
+
+	var cases = []struct {
+		Scanner *NamedReferenceScanner
+		ExpRef  string
+		ExpIdx  int
+	}{
+		{&NamedReferenceScanner{"value", -1}, "", -1},
+		{&NamedReferenceScanner{"&value;", -1}, "value", 0},
+		{&NamedReferenceScanner{"&amp;", -1}, "amp", 0},
+		{&NamedReferenceScanner{"&amp", -1}, "amp", 0},
+		{&NamedReferenceScanner{"&notit;", -1}, "not", 0},
+		{&NamedReferenceScanner{"&notin;", -1}, "notin", 0},
+		{&NamedReferenceScanner{"&notin", -1}, "not", 0},
+		{&NamedReferenceScanner{"a &copy 2014", -1}, "copy", 2},
+		{&NamedReferenceScanner{"a &copy 2014", 2}, "", -1},
+		{&NamedReferenceScanner{"&hearts &lt", -1}, "lt", 8},
+		{&NamedReferenceScanner{"&not \u2318 this;", -1}, "not", 0},
+		{&NamedReferenceScanner{"&but \u2318 &this;", -1}, "this", 9},
+		{&NamedReferenceScanner{";&", -1}, "", -1},
+		{&NamedReferenceScanner{"a &;", -1}, "", -1},
+	}
+
+	for _, c := range cases {
+		actRef, actIdx := c.Scanner.NextLegacy()
+		if actRef != c.ExpRef || actIdx != c.ExpIdx {
+			t.Errorf("Expecting %#v.NextLegacy() to be %q, %d, but got %q, %d.",
+				c.Scanner, c.ExpRef, c.ExpIdx, actRef, actIdx)
+		}
+	}
+}
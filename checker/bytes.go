@@ -0,0 +1,206 @@
+package checker
+
+import (
+	"unsafe"
+)
+
+// The functions in this file are the []byte versions of the checker functions.
+// Each one views its argument as a string, without copying it, and calls the
+// string version, so the two versions always agree.
+
+// bytesToString returns a string that shares its memory with b. It must only
+// be used for arguments that are not kept past the end of the call, and the
+// result must not outlive b.
+//
+func bytesToString(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
+
+// IsValidAttributeNameBytes is like IsValidAttributeName, for a []byte.
+//
+func IsValidAttributeNameBytes(name []byte) bool {
+	return IsValidAttributeName(bytesToString(name))
+}
+
+// IsValidAttributeValueBytes is like IsValidAttributeValue, for a []byte.
+//
+func IsValidAttributeValueBytes(val []byte) bool {
+	return IsValidAttributeValue(bytesToString(val))
+}
+
+// IsValidAttributeValueUnquotedBytes is like IsValidAttributeValueUnquoted,
+// for a []byte.
+//
+func IsValidAttributeValueUnquotedBytes(val []byte) bool {
+	return IsValidAttributeValueUnquoted(bytesToString(val))
+}
+
+// IsValidAttributeValueSingleQuotedBytes is like
+// IsValidAttributeValueSingleQuoted, for a []byte.
+//
+func IsValidAttributeValueSingleQuotedBytes(val []byte) bool {
+	return IsValidAttributeValueSingleQuoted(bytesToString(val))
+}
+
+// IsValidAttributeValueDoubleQuotedBytes is like
+// IsValidAttributeValueDoubleQuoted, for a []byte.
+//
+func IsValidAttributeValueDoubleQuotedBytes(val []byte) bool {
+	return IsValidAttributeValueDoubleQuoted(bytesToString(val))
+}
+
+// HasAmbiguousAmpersandBytes is like HasAmbiguousAmpersand, for a []byte.
+//
+func HasAmbiguousAmpersandBytes(val []byte) bool {
+	return HasAmbiguousAmpersand(bytesToString(val))
+}
+
+// HasLegacyCharacterReferenceBytes is like HasLegacyCharacterReference, for a
+// []byte.
+//
+func HasLegacyCharacterReferenceBytes(val []byte) bool {
+	return HasLegacyCharacterReference(bytesToString(val))
+}
+
+// IsCharacterReferenceNameBytes is like IsCharacterReferenceName, for a
+// []byte.
+//
+func IsCharacterReferenceNameBytes(name []byte) bool {
+	return IsCharacterReferenceName(bytesToString(name))
+}
+
+// IsCharacterReferenceBytes is like IsCharacterReference, for a []byte.
+//
+func IsCharacterReferenceBytes(ref []byte) bool {
+	return IsCharacterReference(bytesToString(ref))
+}
+
+// IsLegacyCharacterReferenceNameBytes is like IsLegacyCharacterReferenceName,
+// for a []byte.
+//
+func IsLegacyCharacterReferenceNameBytes(name []byte) bool {
+	return IsLegacyCharacterReferenceName(bytesToString(name))
+}
+
+// CharacterReferenceRunesBytes is like CharacterReferenceRunes, for a []byte.
+//
+func CharacterReferenceRunesBytes(name []byte) (first, second rune, ok bool) {
+	return CharacterReferenceRunes(bytesToString(name))
+}
+
+// MatchCharacterReferenceBytes is like MatchCharacterReference, for a []byte.
+// The returned name doesn't share memory with the argument.
+//
+func MatchCharacterReferenceBytes(s []byte) (name string, semicolon bool) {
+	return MatchCharacterReference(bytesToString(s))
+}
+
+// LegacyCharacterReferencePrefixBytes is like LegacyCharacterReferencePrefix,
+// for a []byte. The result is a slice of the argument.
+//
+func LegacyCharacterReferencePrefixBytes(s []byte) []byte {
+	return s[:len(LegacyCharacterReferencePrefix(bytesToString(s)))]
+}
+
+// IsValidNumericCharacterReferenceBytes is like
+// IsValidNumericCharacterReference, for a []byte.
+//
+func IsValidNumericCharacterReferenceBytes(ref []byte) (bool, CharacterReferenceError) {
+	return IsValidNumericCharacterReference(bytesToString(ref))
+}
+
+// IsAnyCharacterReferenceBytes is like IsAnyCharacterReference, for a []byte.
+//
+func IsAnyCharacterReferenceBytes(ref []byte) (bool, CharacterReferenceError) {
+	return IsAnyCharacterReference(bytesToString(ref))
+}
+
+// IsValidHtml4IdValueBytes is like IsValidHtml4IdValue, for a []byte.
+//
+func IsValidHtml4IdValueBytes(val []byte) bool {
+	return IsValidHtml4IdValue(bytesToString(val))
+}
+
+// IsValidHtml5IdValueBytes is like IsValidHtml5IdValue, for a []byte.
+//
+func IsValidHtml5IdValueBytes(val []byte) bool {
+	return IsValidHtml5IdValue(bytesToString(val))
+}
+
+// IsValidCss3IdValueBytes is like IsValidCss3IdValue, for a []byte.
+//
+func IsValidCss3IdValueBytes(val []byte) bool {
+	return IsValidCss3IdValue(bytesToString(val))
+}
+
+// IsValidCss3IdentifierBytes is like IsValidCss3Identifier, for a []byte.
+//
+func IsValidCss3IdentifierBytes(val []byte) bool {
+	return IsValidCss3Identifier(bytesToString(val))
+}
+
+// IsHTMLTagNameBytes is like IsHTMLTagName, for a []byte.
+//
+func IsHTMLTagNameBytes(name []byte) bool {
+	return IsHTMLTagName(bytesToString(name))
+}
+
+// IsHTMLTagNameSafeBytes is like IsHTMLTagNameSafe, for a []byte.
+//
+func IsHTMLTagNameSafeBytes(name []byte) bool {
+	return IsHTMLTagNameSafe(bytesToString(name))
+}
+
+// IsValidHTMLTagNameBytes is like IsValidHTMLTagName, for a []byte.
+//
+func IsValidHTMLTagNameBytes(name []byte) bool {
+	return IsValidHTMLTagName(bytesToString(name))
+}
+
+// IsValidTextBytes is like IsValidText, for a []byte.
+//
+func IsValidTextBytes(s []byte) bool {
+	return IsValidText(bytesToString(s))
+}
+
+// IsValidEscapableRawTextBytes is like IsValidEscapableRawText, for a []byte.
+//
+func IsValidEscapableRawTextBytes(tag string, text []byte) bool {
+	return IsValidEscapableRawText(tag, bytesToString(text))
+}
+
+// ContainsEndTagBytes is like ContainsEndTag, for a []byte.
+//
+func ContainsEndTagBytes(text []byte, tag string) bool {
+	return ContainsEndTag(bytesToString(text), tag)
+}
+
+// IsValidRawTextBytes is like IsValidRawText, for a []byte.
+//
+func IsValidRawTextBytes(tag string, text []byte) bool {
+	return IsValidRawText(tag, bytesToString(text))
+}
+
+// IsValidCommentTextBytes is like IsValidCommentText, for a []byte.
+//
+func IsValidCommentTextBytes(text []byte) bool {
+	return IsValidCommentText(bytesToString(text))
+}
+
+// IsValidCDATAContentBytes is like IsValidCDATAContent, for a []byte.
+//
+func IsValidCDATAContentBytes(s []byte) bool {
+	return IsValidCDATAContent(bytesToString(s))
+}
+
+// IsValidSrcsetBytes is like IsValidSrcset, for a []byte.
+//
+func IsValidSrcsetBytes(s []byte) bool {
+	return IsValidSrcset(bytesToString(s))
+}
+
+// IsValidSizesBytes is like IsValidSizes, for a []byte.
+//
+func IsValidSizesBytes(s []byte) bool {
+	return IsValidSizes(bytesToString(s))
+}
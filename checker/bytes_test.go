@@ -0,0 +1,152 @@
+package checker
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// bytesTestInputs are run through both the string and the []byte version of
+// each function, which must agree.
+var bytesTestInputs = []string{
+	"",
+	"a",
+	"A",
+	"1abc",
+	"abc",
+	"a b",
+	"a-b_c:d.e",
+	"#id",
+	"#1id",
+	"--x",
+	`\31 23`,
+	"div",
+	"DiV",
+	"custom-tag",
+	"data-x",
+	"x=y",
+	`"quoted"`,
+	"'quoted'",
+	"<tag>",
+	"</tag>",
+	"</title>",
+	"<!-- <script> -->",
+	"->",
+	"a<!-",
+	"a[b[0]]>1",
+	"`tick`",
+	"⌘",
+	"￿",
+	"\x00",
+	"amp",
+	"&amp;",
+	"&amp",
+	"&copy 2014",
+	"&notit;",
+	"&notin;",
+	"notit;",
+	"nLt;",
+	"&ambiguous;",
+	"&;",
+	"&#65;",
+	"&#x2665;",
+	"&#xD800;",
+	"&#0;",
+	"&#65",
+	"a &lt; b &gt c &tuesday;",
+	"a.png 1x, b.png 2x",
+	"a.png 480w, b.png",
+	"(max-width: 600px) 100vw, 50vw",
+}
+
+func TestBytesVersionsAgree(t *testing.T) {
+
+	bools := map[string][2]func(string) bool{
+		"IsValidAttributeName":              {IsValidAttributeName, bytesFunc(IsValidAttributeNameBytes)},
+		"IsValidAttributeValue":             {IsValidAttributeValue, bytesFunc(IsValidAttributeValueBytes)},
+		"IsValidAttributeValueUnquoted":     {IsValidAttributeValueUnquoted, bytesFunc(IsValidAttributeValueUnquotedBytes)},
+		"IsValidAttributeValueSingleQuoted": {IsValidAttributeValueSingleQuoted, bytesFunc(IsValidAttributeValueSingleQuotedBytes)},
+		"IsValidAttributeValueDoubleQuoted": {IsValidAttributeValueDoubleQuoted, bytesFunc(IsValidAttributeValueDoubleQuotedBytes)},
+		"HasAmbiguousAmpersand":             {HasAmbiguousAmpersand, bytesFunc(HasAmbiguousAmpersandBytes)},
+		"HasLegacyCharacterReference":       {HasLegacyCharacterReference, bytesFunc(HasLegacyCharacterReferenceBytes)},
+		"IsCharacterReferenceName":          {IsCharacterReferenceName, bytesFunc(IsCharacterReferenceNameBytes)},
+		"IsCharacterReference":              {IsCharacterReference, bytesFunc(IsCharacterReferenceBytes)},
+		"IsLegacyCharacterReferenceName":    {IsLegacyCharacterReferenceName, bytesFunc(IsLegacyCharacterReferenceNameBytes)},
+		"IsValidHtml4IdValue":               {IsValidHtml4IdValue, bytesFunc(IsValidHtml4IdValueBytes)},
+		"IsValidHtml5IdValue":               {IsValidHtml5IdValue, bytesFunc(IsValidHtml5IdValueBytes)},
+		"IsValidCss3IdValue":                {IsValidCss3IdValue, bytesFunc(IsValidCss3IdValueBytes)},
+		"IsValidCss3Identifier":             {IsValidCss3Identifier, bytesFunc(IsValidCss3IdentifierBytes)},
+		"IsHTMLTagName":                     {IsHTMLTagName, bytesFunc(IsHTMLTagNameBytes)},
+		"IsHTMLTagNameSafe":                 {IsHTMLTagNameSafe, bytesFunc(IsHTMLTagNameSafeBytes)},
+		"IsValidHTMLTagName":                {IsValidHTMLTagName, bytesFunc(IsValidHTMLTagNameBytes)},
+		"IsValidText":                       {IsValidText, bytesFunc(IsValidTextBytes)},
+		"IsValidCommentText":                {IsValidCommentText, bytesFunc(IsValidCommentTextBytes)},
+		"IsValidCDATAContent":               {IsValidCDATAContent, bytesFunc(IsValidCDATAContentBytes)},
+		"IsValidSrcset":                     {IsValidSrcset, bytesFunc(IsValidSrcsetBytes)},
+		"IsValidSizes":                      {IsValidSizes, bytesFunc(IsValidSizesBytes)},
+	}
+
+	for label, fns := range bools {
+		for _, input := range bytesTestInputs {
+			expected, actual := fns[0](input), fns[1](input)
+			if expected != actual {
+				t.Errorf("%s(%q) is %v, but %sBytes is %v.", label, input, expected, label, actual)
+			}
+		}
+	}
+
+	for _, input := range bytesTestInputs {
+		b := []byte(input)
+
+		if expected, actual := fmt.Sprint(CharacterReferenceRunes(input)), fmt.Sprint(CharacterReferenceRunesBytes(b)); expected != actual {
+			t.Errorf("CharacterReferenceRunes(%q) is %s, but CharacterReferenceRunesBytes is %s.", input, expected, actual)
+		}
+		if expected, actual := fmt.Sprint(MatchCharacterReference(input)), fmt.Sprint(MatchCharacterReferenceBytes(b)); expected != actual {
+			t.Errorf("MatchCharacterReference(%q) is %s, but MatchCharacterReferenceBytes is %s.", input, expected, actual)
+		}
+		if expected, actual := LegacyCharacterReferencePrefix(input), LegacyCharacterReferencePrefixBytes(b); expected != string(actual) {
+			t.Errorf("LegacyCharacterReferencePrefix(%q) is %q, but LegacyCharacterReferencePrefixBytes is %q.", input, expected, actual)
+		}
+		if expected, actual := fmt.Sprint(IsValidNumericCharacterReference(input)), fmt.Sprint(IsValidNumericCharacterReferenceBytes(b)); expected != actual {
+			t.Errorf("IsValidNumericCharacterReference(%q) is %s, but IsValidNumericCharacterReferenceBytes is %s.", input, expected, actual)
+		}
+		if expected, actual := fmt.Sprint(IsAnyCharacterReference(input)), fmt.Sprint(IsAnyCharacterReferenceBytes(b)); expected != actual {
+			t.Errorf("IsAnyCharacterReference(%q) is %s, but IsAnyCharacterReferenceBytes is %s.", input, expected, actual)
+		}
+		if expected, actual := IsValidEscapableRawText("title", input), IsValidEscapableRawTextBytes("title", b); expected != actual {
+			t.Errorf("IsValidEscapableRawText(%q) is %v, but IsValidEscapableRawTextBytes is %v.", input, expected, actual)
+		}
+		if expected, actual := IsValidRawText("script", input), IsValidRawTextBytes("script", b); expected != actual {
+			t.Errorf("IsValidRawText(%q) is %v, but IsValidRawTextBytes is %v.", input, expected, actual)
+		}
+		if expected, actual := ContainsEndTag(input, "tag"), ContainsEndTagBytes(b, "tag"); expected != actual {
+			t.Errorf("ContainsEndTag(%q) is %v, but ContainsEndTagBytes is %v.", input, expected, actual)
+		}
+		if !bytes.Equal(b, []byte(input)) {
+			t.Errorf("The []byte versions modified their argument %q.", input)
+		}
+	}
+}
+
+// bytesFunc adapts a []byte predicate so it can be compared with its string
+// version.
+func bytesFunc(fn func([]byte) bool) func(string) bool {
+	return func(s string) bool {
+		return fn([]byte(s))
+	}
+}
+
+func ExampleHasAmbiguousAmpersandBytes() {
+	fmt.Println(HasAmbiguousAmpersandBytes([]byte("Who &writes; like this?")))
+	// Output:
+	// true
+}
+
+// BenchmarkHasAmbiguousAmpersandBytes  20000000          65.3 ns/op         0 B/op        0 allocs/op
+func BenchmarkHasAmbiguousAmpersandBytes(b *testing.B) {
+	val := []byte("a &lt; b &gt; c")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		HasAmbiguousAmpersandBytes(val)
+	}
+}
@@ -0,0 +1,22 @@
+package checker
+
+import (
+	"strings"
+)
+
+// IsValidCDATAContent returns true if the argument can be the content of a
+// CDATA section, i.e. the part between "<![CDATA[" and "]]>". CDATA sections
+// are only allowed in foreign content, like inline SVG and MathML.
+//
+//     CDATA sections must consist of the following components, in this order:
+//
+//      1. The string "<![CDATA[".
+//      2. Optionally, text, with the additional restriction that the text must
+//         not contain the string "]]>".
+//      3. The string "]]>".
+//
+// From https://html.spec.whatwg.org/multipage/syntax.html#cdata-sections
+//
+func IsValidCDATAContent(s string) bool {
+	return !strings.Contains(s, "]]>")
+}
@@ -0,0 +1,26 @@
+package checker
+
+import (
+	"testing"
+)
+
+func TestIsValidCDATAContent(t *testing.T) {
+	valid := []string{
+		"",
+		"x < y && y > z",
+		"a[b[0]]",
+		"]]",
+		"] ]>",
+		"<![CDATA[",
+	}
+	casesShouldBeTrue(t, valid, IsValidCDATAContent,
+		"Expecting %q to be valid CDATA content, but got false.")
+
+	invalid := []string{
+		"]]>",
+		"a[b[0]]>1",
+		"x ]]> y",
+	}
+	casesShouldBeFalse(t, invalid, IsValidCDATAContent,
+		"Expecting %q to NOT be valid CDATA content, but got true.")
+}
@@ -0,0 +1,2294 @@
+// Code generated by gen_characternames.go from entities.json; DO NOT EDIT.
+
+package checker
+
+// entitiesJSONSource is where entities.json was downloaded from.
+const entitiesJSONSource = "https://html.spec.whatwg.org/entities.json"
+
+// entitiesJSONSHA256 is the SHA-256 of the entities.json this file was
+// generated from.
+const entitiesJSONSHA256 = "6851b878c060e31f4eb4f61941d9911ad510128dbc19fcd979f6cfddf0c624ef"
+
+const (
+	shortestCharacterReferenceName      = 2
+	longestCharacterReferenceName       = 31
+	longestLegacyCharacterReferenceName = 6
+)
+
+// characterReferenceLengths has bit l set in entry c if there is a name of
+// length l that starts with the byte c.
+var characterReferenceLengths = [128]uint32{
+	'A': 0x00002078,
+	'B': 0x000006F8,
+	'C': 0x81308EF8,
+	'D': 0x017FF3FC,
+	'E': 0x00111CF8,
+	'F': 0x00220458,
+	'G': 0x0003587C,
+	'H': 0x00005278,
+	'I': 0x000055FC,
+	'J': 0x00000078,
+	'K': 0x00000078,
+	'L': 0x000FFFDC,
+	'M': 0x00000A1C,
+	'N': 0x00FFFFDC,
+	'O': 0x0010CAFC,
+	'P': 0x000437BC,
+	'Q': 0x00000018,
+	'R': 0x001FFD7C,
+	'S': 0x000EF97C,
+	'T': 0x00004678,
+	'U': 0x0001FFF8,
+	'V': 0x00023878,
+	'W': 0x00000038,
+	'X': 0x0000001C,
+	'Y': 0x00000078,
+	'Z': 0x00004058,
+	'a': 0x000001FC,
+	'b': 0x0006BBF8,
+	'c': 0x0001CFF8,
+	'd': 0x0001EBFC,
+	'e': 0x00001DFC,
+	'f': 0x00002178,
+	'g': 0x000007FC,
+	'h': 0x000063F8,
+	'i': 0x000001FC,
+	'j': 0x00000078,
+	'k': 0x00000078,
+	'l': 0x000EE7FC,
+	'm': 0x000025FC,
+	'n': 0x0001EFFC,
+	'o': 0x000001FC,
+	'p': 0x00000FFC,
+	'q': 0x000008F8,
+	'r': 0x0003D7FC,
+	's': 0x0000AFFC,
+	't': 0x0003FBF8,
+	'u': 0x00006DF8,
+	'v': 0x0001B7F8,
+	'w': 0x0000007C,
+	'x': 0x0000007C,
+	'y': 0x00000078,
+	'z': 0x000000D8,
+}
+
+// characterReferenceSeeds holds the seed of each bucket of the perfect hash.
+// See characterReferenceIndex.
+var characterReferenceSeeds = [1024]uint32{
+	3, 5, 0, 4, 0, 1, 7, 7, 25, 0, 0, 6,
+	0, 0, 6, 7, 1, 0, 7, 20, 5, 16, 0, 4,
+	0, 0, 0, 3, 0, 0, 4, 31, 8, 0, 0, 18,
+	1, 0, 0, 2, 1, 0, 1, 1, 11, 0, 10, 7,
+	2, 6, 1, 2, 2, 2, 17, 20, 0, 7, 5, 2,
+	0, 3, 3, 2, 3, 1, 0, 0, 0, 5, 0, 4,
+	8, 4, 23, 0, 0, 5, 0, 10, 5, 0, 3, 0,
+	7, 21, 21, 0, 3, 12, 4, 2, 10, 18, 0, 0,
+	0, 5, 42, 22, 7, 1, 1, 1, 1, 5, 60, 10,
+	4, 0, 0, 53, 5, 7, 0, 3, 2, 3, 8, 6,
+	12, 0, 27, 9, 0, 0, 8, 0, 2, 10, 6, 2,
+	8, 31, 47, 2, 0, 0, 5, 0, 0, 1, 0, 0,
+	7, 1, 0, 10, 0, 66, 4, 1, 0, 14, 2, 0,
+	0, 19, 13, 4, 10, 1, 32, 0, 9, 7, 2, 12,
+	2, 0, 0, 0, 2, 0, 0, 1, 1, 0, 22, 0,
+	0, 6, 36, 0, 0, 4, 0, 7, 0, 3, 1, 33,
+	0, 1, 1, 2, 18, 0, 10, 0, 61, 1, 38, 6,
+	17, 3, 14, 13, 62, 9, 0, 1, 1, 8, 0, 0,
+	21, 13, 2, 0, 24, 33, 1, 0, 20, 8, 1, 0,
+	4, 0, 15, 4, 10, 1, 0, 7, 2, 23, 6, 0,
+	3, 0, 8, 9, 0, 0, 9, 11, 5, 17, 0, 4,
+	5, 0, 31, 12, 0, 0, 50, 0, 10, 1, 16, 0,
+	0, 6, 0, 0, 2, 0, 2, 4, 72, 18, 4, 72,
+	6, 0, 14, 1, 0, 0, 7, 32, 11, 0, 41, 14,
+	7, 1, 0, 4, 1, 6, 5, 26, 11, 3, 7, 5,
+	13, 3, 0, 4, 1, 1, 0, 4, 9, 0, 6, 13,
+	1, 50, 17, 3, 31, 11, 9, 13, 23, 3, 0, 7,
+	2, 45, 9, 1, 18, 1, 15, 11, 9, 0, 7, 0,
+	45, 3, 20, 0, 12, 17, 2, 3, 7, 8, 0, 0,
+	34, 0, 5, 3, 37, 2, 0, 2, 2, 0, 2, 0,
+	7, 87, 0, 2, 0, 3, 0, 1, 3, 9, 4, 5,
+	0, 82, 1, 2, 0, 33, 0, 5, 4, 0, 2, 0,
+	4, 7, 0, 51, 51, 6, 1, 5, 1, 88, 5, 0,
+	0, 6, 1, 7, 96, 0, 2, 0, 10, 14, 4, 5,
+	2, 8, 3, 0, 16, 4, 2, 20, 0, 0, 0, 37,
+	8, 8, 0, 45, 22, 1, 0, 48, 7, 4, 3, 1,
+	0, 0, 12, 0, 17, 6, 43, 190, 0, 4, 5, 2,
+	0, 2, 2, 15, 5, 0, 0, 0, 0, 14, 10, 33,
+	6, 3, 0, 1, 5, 0, 59, 11, 0, 0, 170, 104,
+	2, 109, 0, 0, 1, 0, 0, 8, 15, 1, 33, 14,
+	6, 96, 28, 1, 1, 0, 4, 0, 0, 23, 0, 13,
+	16, 0, 23, 5, 141, 0, 7, 75, 1, 2, 0, 62,
+	9, 17, 21, 25, 0, 9, 11, 1, 3, 20, 0, 0,
+	2, 0, 2, 2, 0, 24, 1, 0, 0, 5, 0, 2,
+	1, 0, 1, 9, 9, 0, 5, 45, 16, 1, 2, 1,
+	20, 3, 3, 4, 47, 26, 2, 9, 0, 2, 3, 7,
+	16, 1, 3, 0, 4, 5, 9, 2, 16, 0, 0, 1,
+	1, 0, 0, 18, 2, 1, 318, 12, 0, 219, 0, 11,
+	1, 4, 232, 9, 65, 65, 9, 24, 1, 20, 1, 9,
+	2, 8, 0, 3, 0, 401, 16, 1, 22, 0, 36, 4,
+	0, 0, 23, 0, 8, 2, 19, 31, 23, 1, 22, 540,
+	2, 0, 66, 0, 10, 27, 10, 1, 33, 1, 69, 7,
+	73, 89, 16, 1, 4, 1, 5, 4, 2, 1, 5, 8,
+	196, 4, 7, 230, 37, 146, 2, 146, 9, 39, 33, 0,
+	29, 0, 51, 0, 3, 0, 10, 48, 0, 7, 7, 5,
+	10, 4, 13, 0, 0, 3, 9, 35, 0, 1, 4, 49,
+	28, 8, 33, 0, 0, 1036, 0, 0, 1, 6, 2, 15,
+	98, 0, 0, 0, 0, 0, 4, 6, 113, 748, 128, 0,
+	29, 24, 3, 0, 12, 71, 16, 75, 1, 38, 109, 2,
+	9, 40, 94, 151, 61, 5, 7, 2, 36, 0, 0, 203,
+	8, 11, 35, 39, 0, 26, 118, 42, 1, 37, 110, 7,
+	48, 5, 0, 30, 0, 2067, 8, 11, 166, 0, 40, 0,
+	10, 0, 14, 1079, 0, 150, 1, 1, 0, 1, 20, 0,
+	19, 178, 69, 6, 70, 13, 1, 0, 18, 5, 1137, 0,
+	1, 18, 2435, 6, 2, 136, 31, 155, 1169, 0, 0, 14,
+	9, 5, 28, 1, 20, 15, 33, 32, 7, 64, 9, 1,
+	2, 0, 0, 0, 3, 0, 6, 5, 67, 27, 1, 13,
+	11, 301, 73, 0, 3457, 7, 0, 27, 9, 26, 65, 0,
+	0, 212, 1037, 2383, 9, 0, 3, 0, 1, 38, 0, 14,
+	10, 106, 32, 12, 10, 136, 24, 23, 3, 0, 93, 0,
+	0, 0, 50, 10, 0, 2, 0, 86, 3, 16, 8, 2,
+	9, 36, 49, 138, 103, 32, 16, 18, 30, 6, 18, 0,
+	5, 0, 3095, 81, 50, 18, 20, 4, 1, 27, 2, 79,
+	0, 0, 4098, 0, 0, 35, 1, 3, 196, 2200, 16, 3,
+	45, 2058, 9, 8, 11, 112, 201, 33, 30, 128, 4116, 15,
+	3, 99, 20, 0, 1961, 5, 4143, 10, 4343, 0, 2125, 65,
+	5, 484, 125, 0, 0, 28, 0, 141, 4405, 43, 16, 0,
+	0, 7, 0, 0, 102, 0, 0, 0, 1, 39, 2, 74,
+	113, 4744, 6, 6, 6, 165, 30, 8, 46, 41, 0, 4833,
+	21, 4226, 3, 3410, 17, 0, 1037, 0, 0, 2, 15, 81,
+	35, 225, 390, 55, 13, 0, 2, 9, 394, 1, 106, 314,
+	12, 26, 0, 2178, 3906, 13, 3, 1, 4102, 0, 2190, 159,
+	9, 11, 4216, 6, 8, 12, 1, 4237, 11, 0, 33, 3,
+	13, 71, 0, 0, 20, 0, 30, 5321, 23, 0, 0, 0,
+	392, 51, 4523, 22, 36, 111, 13504, 4, 2377, 153, 43, 0,
+	0, 171, 8438, 12,
+}
+
+// characterReferenceTable holds every character reference, in perfect hash
+// order. See characterReferenceIndex.
+var characterReferenceTable = [2125]characterReference{
+	{"succeq", [2]rune{0x2AB0}, false},
+	{"rationals", [2]rune{0x211A}, false},
+	{"triangledown", [2]rune{0x25BF}, false},
+	{"nsimeq", [2]rune{0x2244}, false},
+	{"triangle", [2]rune{0x25B5}, false},
+	{"Bernoullis", [2]rune{0x212C}, false},
+	{"urcorn", [2]rune{0x231D}, false},
+	{"awint", [2]rune{0x2A11}, false},
+	{"Del", [2]rune{0x2207}, false},
+	{"cirscir", [2]rune{0x29C2}, false},
+	{"Imacr", [2]rune{0x012A}, false},
+	{"DiacriticalDoubleAcute", [2]rune{0x02DD}, false},
+	{"rAarr", [2]rune{0x21DB}, false},
+	{"Kscr", [2]rune{0x1D4A6}, false},
+	{"YIcy", [2]rune{0x0407}, false},
+	{"frac18", [2]rune{0x215B}, false},
+	{"Scy", [2]rune{0x0421}, false},
+	{"subseteq", [2]rune{0x2286}, false},
+	{"brvbar", [2]rune{0x00A6}, true},
+	{"RoundImplies", [2]rune{0x2970}, false},
+	{"rarrpl", [2]rune{0x2945}, false},
+	{"diam", [2]rune{0x22C4}, false},
+	{"Implies", [2]rune{0x21D2}, false},
+	{"lBarr", [2]rune{0x290E}, false},
+	{"ngt", [2]rune{0x226F}, false},
+	{"real", [2]rune{0x211C}, false},
+	{"updownarrow", [2]rune{0x2195}, false},
+	{"UpArrowDownArrow", [2]rune{0x21C5}, false},
+	{"lescc", [2]rune{0x2AA8}, false},
+	{"bump", [2]rune{0x224E}, false},
+	{"circledR", [2]rune{0x00AE}, false},
+	{"Precedes", [2]rune{0x227A}, false},
+	{"frac15", [2]rune{0x2155}, false},
+	{"ufisht", [2]rune{0x297E}, false},
+	{"ccups", [2]rune{0x2A4C}, false},
+	{"ssmile", [2]rune{0x2323}, false},
+	{"Gopf", [2]rune{0x1D53E}, false},
+	{"zwnj", [2]rune{0x200C}, false},
+	{"filig", [2]rune{0xFB01}, false},
+	{"UnionPlus", [2]rune{0x228E}, false},
+	{"Re", [2]rune{0x211C}, false},
+	{"LeftArrowRightArrow", [2]rune{0x21C6}, false},
+	{"ndash", [2]rune{0x2013}, false},
+	{"ugrave", [2]rune{0x00F9}, true},
+	{"imath", [2]rune{0x0131}, false},
+	{"Lcy", [2]rune{0x041B}, false},
+	{"lmidot", [2]rune{0x0140}, false},
+	{"aring", [2]rune{0x00E5}, true},
+	{"nshortmid", [2]rune{0x2224}, false},
+	{"lE", [2]rune{0x2266}, false},
+	{"gnapprox", [2]rune{0x2A8A}, false},
+	{"Int", [2]rune{0x222C}, false},
+	{"Egrave", [2]rune{0x00C8}, true},
+	{"TildeFullEqual", [2]rune{0x2245}, false},
+	{"vrtri", [2]rune{0x22B3}, false},
+	{"Fopf", [2]rune{0x1D53D}, false},
+	{"Oscr", [2]rune{0x1D4AA}, false},
+	{"Ll", [2]rune{0x22D8}, false},
+	{"iiota", [2]rune{0x2129}, false},
+	{"rlm", [2]rune{0x200F}, false},
+	{"risingdotseq", [2]rune{0x2253}, false},
+	{"acd", [2]rune{0x223F}, false},
+	{"gtreqqless", [2]rune{0x2A8C}, false},
+	{"late", [2]rune{0x2AAD}, false},
+	{"tstrok", [2]rune{0x0167}, false},
+	{"nang", [2]rune{0x2220, 0x20D2}, false},
+	{"VerticalSeparator", [2]rune{0x2758}, false},
+	{"scaron", [2]rune{0x0161}, false},
+	{"precnsim", [2]rune{0x22E8}, false},
+	{"lbrkslu", [2]rune{0x298D}, false},
+	{"gnap", [2]rune{0x2A8A}, false},
+	{"frac16", [2]rune{0x2159}, false},
+	{"rdquo", [2]rune{0x201D}, false},
+	{"gtcc", [2]rune{0x2AA7}, false},
+	{"coprod", [2]rune{0x2210}, false},
+	{"hstrok", [2]rune{0x0127}, false},
+	{"GreaterFullEqual", [2]rune{0x2267}, false},
+	{"frac13", [2]rune{0x2153}, false},
+	{"heartsuit", [2]rune{0x2665}, false},
+	{"plusdu", [2]rune{0x2A25}, false},
+	{"ncedil", [2]rune{0x0146}, false},
+	{"GreaterLess", [2]rune{0x2277}, false},
+	{"yopf", [2]rune{0x1D56A}, false},
+	{"nsucceq", [2]rune{0x2AB0, 0x0338}, false},
+	{"xopf", [2]rune{0x1D569}, false},
+	{"simrarr", [2]rune{0x2972}, false},
+	{"mldr", [2]rune{0x2026}, false},
+	{"GreaterTilde", [2]rune{0x2273}, false},
+	{"rrarr", [2]rune{0x21C9}, false},
+	{"subdot", [2]rune{0x2ABD}, false},
+	{"Dstrok", [2]rune{0x0110}, false},
+	{"NotNestedGreaterGreater", [2]rune{0x2AA2, 0x0338}, false},
+	{"Utilde", [2]rune{0x0168}, false},
+	{"rfr", [2]rune{0x1D52F}, false},
+	{"Jscr", [2]rune{0x1D4A5}, false},
+	{"nldr", [2]rune{0x2025}, false},
+	{"EmptyVerySmallSquare", [2]rune{0x25AB}, false},
+	{"sqsupset", [2]rune{0x2290}, false},
+	{"nrtri", [2]rune{0x22EB}, false},
+	{"auml", [2]rune{0x00E4}, true},
+	{"setminus", [2]rune{0x2216}, false},
+	{"succsim", [2]rune{0x227F}, false},
+	{"tshcy", [2]rune{0x045B}, false},
+	{"lbrksld", [2]rune{0x298F}, false},
+	{"supseteqq", [2]rune{0x2AC6}, false},
+	{"duarr", [2]rune{0x21F5}, false},
+	{"bigcirc", [2]rune{0x25EF}, false},
+	{"plusdo", [2]rune{0x2214}, false},
+	{"curlyvee", [2]rune{0x22CE}, false},
+	{"Gbreve", [2]rune{0x011E}, false},
+	{"DownRightVectorBar", [2]rune{0x2957}, false},
+	{"nsubE", [2]rune{0x2AC5, 0x0338}, false},
+	{"rbrkslu", [2]rune{0x2990}, false},
+	{"subsetneqq", [2]rune{0x2ACB}, false},
+	{"Phi", [2]rune{0x03A6}, false},
+	{"origof", [2]rune{0x22B6}, false},
+	{"rharul", [2]rune{0x296C}, false},
+	{"rBarr", [2]rune{0x290F}, false},
+	{"nvinfin", [2]rune{0x29DE}, false},
+	{"ntrianglerighteq", [2]rune{0x22ED}, false},
+	{"NotSubset", [2]rune{0x2282, 0x20D2}, false},
+	{"Sigma", [2]rune{0x03A3}, false},
+	{"sqsubseteq", [2]rune{0x2291}, false},
+	{"Zfr", [2]rune{0x2128}, false},
+	{"chi", [2]rune{0x03C7}, false},
+	{"RightArrowBar", [2]rune{0x21E5}, false},
+	{"DownRightTeeVector", [2]rune{0x295F}, false},
+	{"Ccaron", [2]rune{0x010C}, false},
+	{"varsupsetneq", [2]rune{0x228B, 0xFE00}, false},
+	{"strns", [2]rune{0x00AF}, false},
+	{"Efr", [2]rune{0x1D508}, false},
+	{"supsup", [2]rune{0x2AD6}, false},
+	{"epsilon", [2]rune{0x03B5}, false},
+	{"atilde", [2]rune{0x00E3}, true},
+	{"leftarrow", [2]rune{0x2190}, false},
+	{"sdote", [2]rune{0x2A66}, false},
+	{"Eopf", [2]rune{0x1D53C}, false},
+	{"LeftDownVector", [2]rune{0x21C3}, false},
+	{"rcy", [2]rune{0x0440}, false},
+	{"blk34", [2]rune{0x2593}, false},
+	{"fflig", [2]rune{0xFB00}, false},
+	{"ldca", [2]rune{0x2936}, false},
+	{"nparsl", [2]rune{0x2AFD, 0x20E5}, false},
+	{"circledS", [2]rune{0x24C8}, false},
+	{"eqcirc", [2]rune{0x2256}, false},
+	{"Iopf", [2]rune{0x1D540}, false},
+	{"rbrksld", [2]rune{0x298E}, false},
+	{"CapitalDifferentialD", [2]rune{0x2145}, false},
+	{"rsaquo", [2]rune{0x203A}, false},
+	{"lesg", [2]rune{0x22DA, 0xFE00}, false},
+	{"smtes", [2]rune{0x2AAC, 0xFE00}, false},
+	{"Zeta", [2]rune{0x0396}, false},
+	{"lrarr", [2]rune{0x21C6}, false},
+	{"pointint", [2]rune{0x2A15}, false},
+	{"Itilde", [2]rune{0x0128}, false},
+	{"dot", [2]rune{0x02D9}, false},
+	{"lozenge", [2]rune{0x25CA}, false},
+	{"bsolb", [2]rune{0x29C5}, false},
+	{"smt", [2]rune{0x2AAA}, false},
+	{"xcap", [2]rune{0x22C2}, false},
+	{"gap", [2]rune{0x2A86}, false},
+	{"kfr", [2]rune{0x1D528}, false},
+	{"vee", [2]rune{0x2228}, false},
+	{"downharpoonright", [2]rune{0x21C2}, false},
+	{"angrt", [2]rune{0x221F}, false},
+	{"ggg", [2]rune{0x22D9}, false},
+	{"Dopf", [2]rune{0x1D53B}, false},
+	{"Ofr", [2]rune{0x1D512}, false},
+	{"rarrap", [2]rune{0x2975}, false},
+	{"DownBreve", [2]rune{0x0311}, false},
+	{"wopf", [2]rune{0x1D568}, false},
+	{"Hscr", [2]rune{0x210B}, false},
+	{"RightTriangleBar", [2]rune{0x29D0}, false},
+	{"DownLeftTeeVector", [2]rune{0x295E}, false},
+	{"Xfr", [2]rune{0x1D51B}, false},
+	{"dHar", [2]rune{0x2965}, false},
+	{"blacksquare", [2]rune{0x25AA}, false},
+	{"LeftTriangleEqual", [2]rune{0x22B4}, false},
+	{"NestedGreaterGreater", [2]rune{0x226B}, false},
+	{"Hat", [2]rune{0x005E}, false},
+	{"scnap", [2]rune{0x2ABA}, false},
+	{"nrtrie", [2]rune{0x22ED}, false},
+	{"OverBar", [2]rune{0x203E}, false},
+	{"smile", [2]rune{0x2323}, false},
+	{"RightFloor", [2]rune{0x230B}, false},
+	{"Uparrow", [2]rune{0x21D1}, false},
+	{"Ecy", [2]rune{0x042D}, false},
+	{"lang", [2]rune{0x27E8}, false},
+	{"plusacir", [2]rune{0x2A23}, false},
+	{"rdquor", [2]rune{0x201D}, false},
+	{"varpropto", [2]rune{0x221D}, false},
+	{"LessEqualGreater", [2]rune{0x22DA}, false},
+	{"ldrdhar", [2]rune{0x2967}, false},
+	{"npre", [2]rune{0x2AAF, 0x0338}, false},
+	{"circleddash", [2]rune{0x229D}, false},
+	{"nLtv", [2]rune{0x226A, 0x0338}, false},
+	{"iecy", [2]rune{0x0435}, false},
+	{"TRADE", [2]rune{0x2122}, false},
+	{"Iscr", [2]rune{0x2110}, false},
+	{"npart", [2]rune{0x2202, 0x0338}, false},
+	{"nsubseteq", [2]rune{0x2288}, false},
+	{"kcy", [2]rune{0x043A}, false},
+	{"swarrow", [2]rune{0x2199}, false},
+	{"cupdot", [2]rune{0x228D}, false},
+	{"supmult", [2]rune{0x2AC2}, false},
+	{"edot", [2]rune{0x0117}, false},
+	{"SucceedsSlantEqual", [2]rune{0x227D}, false},
+	{"NotGreaterTilde", [2]rune{0x2275}, false},
+	{"Omicron", [2]rune{0x039F}, false},
+	{"horbar", [2]rune{0x2015}, false},
+	{"supsub", [2]rune{0x2AD4}, false},
+	{"DoubleRightArrow", [2]rune{0x21D2}, false},
+	{"zscr", [2]rune{0x1D4CF}, false},
+	{"Copf", [2]rune{0x2102}, false},
+	{"cylcty", [2]rune{0x232D}, false},
+	{"leq", [2]rune{0x2264}, false},
+	{"imagline", [2]rune{0x2110}, false},
+	{"Square", [2]rune{0x25A1}, false},
+	{"vopf", [2]rune{0x1D567}, false},
+	{"roarr", [2]rune{0x21FE}, false},
+	{"loarr", [2]rune{0x21FD}, false},
+	{"Kappa", [2]rune{0x039A}, false},
+	{"circeq", [2]rune{0x2257}, false},
+	{"LeftTeeArrow", [2]rune{0x21A4}, false},
+	{"lrtri", [2]rune{0x22BF}, false},
+	{"Qfr", [2]rune{0x1D514}, false},
+	{"npolint", [2]rune{0x2A14}, false},
+	{"GreaterEqual", [2]rune{0x2265}, false},
+	{"pluscir", [2]rune{0x2A22}, false},
+	{"Tab", [2]rune{0x0009}, false},
+	{"trisb", [2]rune{0x29CD}, false},
+	{"Upsi", [2]rune{0x03D2}, false},
+	{"lowast", [2]rune{0x2217}, false},
+	{"ImaginaryI", [2]rune{0x2148}, false},
+	{"otimesas", [2]rune{0x2A36}, false},
+	{"ntriangleright", [2]rune{0x22EB}, false},
+	{"raquo", [2]rune{0x00BB}, true},
+	{"uogon", [2]rune{0x0173}, false},
+	{"nVdash", [2]rune{0x22AE}, false},
+	{"Esim", [2]rune{0x2A73}, false},
+	{"ddagger", [2]rune{0x2021}, false},
+	{"andv", [2]rune{0x2A5A}, false},
+	{"Iacute", [2]rune{0x00CD}, true},
+	{"Uacute", [2]rune{0x00DA}, true},
+	{"YUcy", [2]rune{0x042E}, false},
+	{"szlig", [2]rune{0x00DF}, true},
+	{"Tau", [2]rune{0x03A4}, false},
+	{"ohm", [2]rune{0x03A9}, false},
+	{"lsh", [2]rune{0x21B0}, false},
+	{"Fscr", [2]rune{0x2131}, false},
+	{"lcaron", [2]rune{0x013E}, false},
+	{"div", [2]rune{0x00F7}, false},
+	{"Vdash", [2]rune{0x22A9}, false},
+	{"yscr", [2]rune{0x1D4CE}, false},
+	{"uopf", [2]rune{0x1D566}, false},
+	{"leftrightharpoons", [2]rune{0x21CB}, false},
+	{"DiacriticalTilde", [2]rune{0x02DC}, false},
+	{"bigstar", [2]rune{0x2605}, false},
+	{"equiv", [2]rune{0x2261}, false},
+	{"supdot", [2]rune{0x2ABE}, false},
+	{"bdquo", [2]rune{0x201E}, false},
+	{"tosa", [2]rune{0x2929}, false},
+	{"yacute", [2]rune{0x00FD}, true},
+	{"die", [2]rune{0x00A8}, false},
+	{"easter", [2]rune{0x2A6E}, false},
+	{"puncsp", [2]rune{0x2008}, false},
+	{"Bopf", [2]rune{0x1D539}, false},
+	{"Dagger", [2]rune{0x2021}, false},
+	{"larrtl", [2]rune{0x21A2}, false},
+	{"dfr", [2]rune{0x1D521}, false},
+	{"uArr", [2]rune{0x21D1}, false},
+	{"plusmn", [2]rune{0x00B1}, true},
+	{"aleph", [2]rune{0x2135}, false},
+	{"Uarr", [2]rune{0x219F}, false},
+	{"xlarr", [2]rune{0x27F5}, false},
+	{"scedil", [2]rune{0x015F}, false},
+	{"looparrowright", [2]rune{0x21AC}, false},
+	{"LongRightArrow", [2]rune{0x27F6}, false},
+	{"mnplus", [2]rune{0x2213}, false},
+	{"sqsup", [2]rune{0x2290}, false},
+	{"Ntilde", [2]rune{0x00D1}, true},
+	{"nsime", [2]rune{0x2244}, false},
+	{"omega", [2]rune{0x03C9}, false},
+	{"aacute", [2]rune{0x00E1}, true},
+	{"subedot", [2]rune{0x2AC3}, false},
+	{"nltrie", [2]rune{0x22EC}, false},
+	{"intercal", [2]rune{0x22BA}, false},
+	{"rdldhar", [2]rune{0x2969}, false},
+	{"Nu", [2]rune{0x039D}, false},
+	{"gesl", [2]rune{0x22DB, 0xFE00}, false},
+	{"QUOT", [2]rune{0x0022}, true},
+	{"nsupseteqq", [2]rune{0x2AC6, 0x0338}, false},
+	{"Lleftarrow", [2]rune{0x21DA}, false},
+	{"odblac", [2]rune{0x0151}, false},
+	{"ubrcy", [2]rune{0x045E}, false},
+	{"ngE", [2]rune{0x2267, 0x0338}, false},
+	{"NegativeVeryThinSpace", [2]rune{0x200B}, false},
+	{"nrarr", [2]rune{0x219B}, false},
+	{"cups", [2]rune{0x222A, 0xFE00}, false},
+	{"incare", [2]rune{0x2105}, false},
+	{"lsime", [2]rune{0x2A8D}, false},
+	{"topf", [2]rune{0x1D565}, false},
+	{"Aopf", [2]rune{0x1D538}, false},
+	{"LowerRightArrow", [2]rune{0x2198}, false},
+	{"hoarr", [2]rune{0x21FF}, false},
+	{"UpEquilibrium", [2]rune{0x296E}, false},
+	{"uhblk", [2]rune{0x2580}, false},
+	{"lfloor", [2]rune{0x230A}, false},
+	{"Escr", [2]rune{0x2130}, false},
+	{"thorn", [2]rune{0x00FE}, true},
+	{"Hopf", [2]rune{0x210D}, false},
+	{"NotPrecedesEqual", [2]rune{0x2AAF, 0x0338}, false},
+	{"andand", [2]rune{0x2A55}, false},
+	{"RightCeiling", [2]rune{0x2309}, false},
+	{"NotVerticalBar", [2]rune{0x2224}, false},
+	{"and", [2]rune{0x2227}, false},
+	{"nspar", [2]rune{0x2226}, false},
+	{"cdot", [2]rune{0x010B}, false},
+	{"inodot", [2]rune{0x0131}, false},
+	{"orslope", [2]rune{0x2A57}, false},
+	{"RBarr", [2]rune{0x2910}, false},
+	{"gtrapprox", [2]rune{0x2A86}, false},
+	{"laquo", [2]rune{0x00AB}, true},
+	{"Rsh", [2]rune{0x21B1}, false},
+	{"wfr", [2]rune{0x1D534}, false},
+	{"Map", [2]rune{0x2905}, false},
+	{"Jfr", [2]rune{0x1D50D}, false},
+	{"PrecedesTilde", [2]rune{0x227E}, false},
+	{"Ccedil", [2]rune{0x00C7}, true},
+	{"yicy", [2]rune{0x0457}, false},
+	{"supsim", [2]rune{0x2AC8}, false},
+	{"because", [2]rune{0x2235}, false},
+	{"rlarr", [2]rune{0x21C4}, false},
+	{"numero", [2]rune{0x2116}, false},
+	{"digamma", [2]rune{0x03DD}, false},
+	{"pre", [2]rune{0x2AAF}, false},
+	{"ltlarr", [2]rune{0x2976}, false},
+	{"wscr", [2]rune{0x1D4CC}, false},
+	{"sdotb", [2]rune{0x22A1}, false},
+	{"ni", [2]rune{0x220B}, false},
+	{"circledcirc", [2]rune{0x229A}, false},
+	{"straightphi", [2]rune{0x03D5}, false},
+	{"LessLess", [2]rune{0x2AA1}, false},
+	{"Dscr", [2]rune{0x1D49F}, false},
+	{"cup", [2]rune{0x222A}, false},
+	{"caron", [2]rune{0x02C7}, false},
+	{"quatint", [2]rune{0x2A16}, false},
+	{"cong", [2]rune{0x2245}, false},
+	{"sopf", [2]rune{0x1D564}, false},
+	{"vert", [2]rune{0x007C}, false},
+	{"Tcaron", [2]rune{0x0164}, false},
+	{"lsimg", [2]rune{0x2A8F}, false},
+	{"Union", [2]rune{0x22C3}, false},
+	{"uplus", [2]rune{0x228E}, false},
+	{"timesbar", [2]rune{0x2A31}, false},
+	{"Jcy", [2]rune{0x0419}, false},
+	{"llarr", [2]rune{0x21C7}, false},
+	{"smallsetminus", [2]rune{0x2216}, false},
+	{"capbrcup", [2]rune{0x2A49}, false},
+	{"sqsub", [2]rune{0x228F}, false},
+	{"cedil", [2]rune{0x00B8}, true},
+	{"vscr", [2]rune{0x1D4CB}, false},
+	{"nsmid", [2]rune{0x2224}, false},
+	{"cross", [2]rune{0x2717}, false},
+	{"Cconint", [2]rune{0x2230}, false},
+	{"between", [2]rune{0x226C}, false},
+	{"vltri", [2]rune{0x22B2}, false},
+	{"lArr", [2]rune{0x21D0}, false},
+	{"simeq", [2]rune{0x2243}, false},
+	{"quaternions", [2]rune{0x210D}, false},
+	{"notin", [2]rune{0x2209}, false},
+	{"gdot", [2]rune{0x0121}, false},
+	{"nles", [2]rune{0x2A7D, 0x0338}, false},
+	{"simne", [2]rune{0x2246}, false},
+	{"submult", [2]rune{0x2AC1}, false},
+	{"ltcir", [2]rune{0x2A79}, false},
+	{"lneqq", [2]rune{0x2268}, false},
+	{"parallel", [2]rune{0x2225}, false},
+	{"scap", [2]rune{0x2AB8}, false},
+	{"Cscr", [2]rune{0x1D49E}, false},
+	{"qopf", [2]rune{0x1D562}, false},
+	{"supne", [2]rune{0x228B}, false},
+	{"andd", [2]rune{0x2A5C}, false},
+	{"rppolint", [2]rune{0x2A12}, false},
+	{"supplus", [2]rune{0x2AC0}, false},
+	{"subsetneq", [2]rune{0x228A}, false},
+	{"piv", [2]rune{0x03D6}, false},
+	{"ogon", [2]rune{0x02DB}, false},
+	{"wr", [2]rune{0x2240}, false},
+	{"Ograve", [2]rune{0x00D2}, true},
+	{"midcir", [2]rune{0x2AF0}, false},
+	{"xscr", [2]rune{0x1D4CD}, false},
+	{"pfr", [2]rune{0x1D52D}, false},
+	{"Gammad", [2]rune{0x03DC}, false},
+	{"complexes", [2]rune{0x2102}, false},
+	{"sext", [2]rune{0x2736}, false},
+	{"RightUpVectorBar", [2]rune{0x2954}, false},
+	{"congdot", [2]rune{0x2A6D}, false},
+	{"Rarr", [2]rune{0x21A0}, false},
+	{"ncongdot", [2]rune{0x2A6D, 0x0338}, false},
+	{"LeftDoubleBracket", [2]rune{0x27E6}, false},
+	{"ropf", [2]rune{0x1D563}, false},
+	{"ContourIntegral", [2]rune{0x222E}, false},
+	{"MediumSpace", [2]rune{0x205F}, false},
+	{"ycirc", [2]rune{0x0177}, false},
+	{"sqcap", [2]rune{0x2293}, false},
+	{"DownRightVector", [2]rune{0x21C1}, false},
+	{"ldquo", [2]rune{0x201C}, false},
+	{"capdot", [2]rune{0x2A40}, false},
+	{"rceil", [2]rune{0x2309}, false},
+	{"nlarr", [2]rune{0x219A}, false},
+	{"flat", [2]rune{0x266D}, false},
+	{"rightthreetimes", [2]rune{0x22CC}, false},
+	{"vBar", [2]rune{0x2AE8}, false},
+	{"uscr", [2]rune{0x1D4CA}, false},
+	{"Bscr", [2]rune{0x212C}, false},
+	{"barwed", [2]rune{0x2305}, false},
+	{"ang", [2]rune{0x2220}, false},
+	{"DifferentialD", [2]rune{0x2146}, false},
+	{"Nacute", [2]rune{0x0143}, false},
+	{"bsime", [2]rune{0x22CD}, false},
+	{"NotSquareSubset", [2]rune{0x228F, 0x0338}, false},
+	{"tscr", [2]rune{0x1D4C9}, false},
+	{"Cfr", [2]rune{0x212D}, false},
+	{"notinE", [2]rune{0x22F9, 0x0338}, false},
+	{"varepsilon", [2]rune{0x03F5}, false},
+	{"Zacute", [2]rune{0x0179}, false},
+	{"nsupe", [2]rune{0x2289}, false},
+	{"ocirc", [2]rune{0x00F4}, true},
+	{"forall", [2]rune{0x2200}, false},
+	{"Proportional", [2]rune{0x221D}, false},
+	{"UnderBracket", [2]rune{0x23B5}, false},
+	{"blacktriangleright", [2]rune{0x25B8}, false},
+	{"iogon", [2]rune{0x012F}, false},
+	{"minus", [2]rune{0x2212}, false},
+	{"xharr", [2]rune{0x27F7}, false},
+	{"succ", [2]rune{0x227B}, false},
+	{"drcrop", [2]rune{0x230C}, false},
+	{"racute", [2]rune{0x0155}, false},
+	{"Cup", [2]rune{0x22D3}, false},
+	{"nleqq", [2]rune{0x2266, 0x0338}, false},
+	{"jsercy", [2]rune{0x0458}, false},
+	{"trianglerighteq", [2]rune{0x22B5}, false},
+	{"pcy", [2]rune{0x043F}, false},
+	{"EqualTilde", [2]rune{0x2242}, false},
+	{"nleftarrow", [2]rune{0x219A}, false},
+	{"xoplus", [2]rune{0x2A01}, false},
+	{"lat", [2]rune{0x2AAB}, false},
+	{"RightDownVectorBar", [2]rune{0x2955}, false},
+	{"tritime", [2]rune{0x2A3B}, false},
+	{"boxHd", [2]rune{0x2564}, false},
+	{"rmoust", [2]rune{0x23B1}, false},
+	{"RightVectorBar", [2]rune{0x2953}, false},
+	{"rhard", [2]rune{0x21C1}, false},
+	{"LeftUpTeeVector", [2]rune{0x2960}, false},
+	{"nLt", [2]rune{0x226A, 0x20D2}, false},
+	{"dlcrop", [2]rune{0x230D}, false},
+	{"LowerLeftArrow", [2]rune{0x2199}, false},
+	{"ETH", [2]rune{0x00D0}, true},
+	{"NotCupCap", [2]rune{0x226D}, false},
+	{"prnE", [2]rune{0x2AB5}, false},
+	{"nsup", [2]rune{0x2285}, false},
+	{"nu", [2]rune{0x03BD}, false},
+	{"plus", [2]rune{0x002B}, false},
+	{"ldrushar", [2]rune{0x294B}, false},
+	{"SquareIntersection", [2]rune{0x2293}, false},
+	{"curlywedge", [2]rune{0x22CF}, false},
+	{"minusb", [2]rune{0x229F}, false},
+	{"ntrianglelefteq", [2]rune{0x22EC}, false},
+	{"rightrightarrows", [2]rune{0x21C9}, false},
+	{"eqslantgtr", [2]rune{0x2A96}, false},
+	{"eogon", [2]rune{0x0119}, false},
+	{"nsupE", [2]rune{0x2AC6, 0x0338}, false},
+	{"thkap", [2]rune{0x2248}, false},
+	{"lltri", [2]rune{0x25FA}, false},
+	{"egs", [2]rune{0x2A96}, false},
+	{"trianglelefteq", [2]rune{0x22B4}, false},
+	{"nltri", [2]rune{0x22EA}, false},
+	{"popf", [2]rune{0x1D561}, false},
+	{"NotLessLess", [2]rune{0x226A, 0x0338}, false},
+	{"succnapprox", [2]rune{0x2ABA}, false},
+	{"lessdot", [2]rune{0x22D6}, false},
+	{"rharu", [2]rune{0x21C0}, false},
+	{"ubreve", [2]rune{0x016D}, false},
+	{"gtrdot", [2]rune{0x22D7}, false},
+	{"wreath", [2]rune{0x2240}, false},
+	{"wcirc", [2]rune{0x0175}, false},
+	{"UpperLeftArrow", [2]rune{0x2196}, false},
+	{"ecaron", [2]rune{0x011B}, false},
+	{"varpi", [2]rune{0x03D6}, false},
+	{"NoBreak", [2]rune{0x2060}, false},
+	{"lcedil", [2]rune{0x013C}, false},
+	{"thksim", [2]rune{0x223C}, false},
+	{"bumpeq", [2]rune{0x224F}, false},
+	{"nvgt", [2]rune{0x003E, 0x20D2}, false},
+	{"UpArrow", [2]rune{0x2191}, false},
+	{"subplus", [2]rune{0x2ABF}, false},
+	{"colone", [2]rune{0x2254}, false},
+	{"lap", [2]rune{0x2A85}, false},
+	{"nap", [2]rune{0x2249}, false},
+	{"ucirc", [2]rune{0x00FB}, true},
+	{"longleftarrow", [2]rune{0x27F5}, false},
+	{"scirc", [2]rune{0x015D}, false},
+	{"straightepsilon", [2]rune{0x03F5}, false},
+	{"prE", [2]rune{0x2AB3}, false},
+	{"preceq", [2]rune{0x2AAF}, false},
+	{"lharu", [2]rune{0x21BC}, false},
+	{"lopar", [2]rune{0x2985}, false},
+	{"tint", [2]rune{0x222D}, false},
+	{"veebar", [2]rune{0x22BB}, false},
+	{"aogon", [2]rune{0x0105}, false},
+	{"prec", [2]rune{0x227A}, false},
+	{"upsih", [2]rune{0x03D2}, false},
+	{"downharpoonleft", [2]rune{0x21C3}, false},
+	{"iiiint", [2]rune{0x2A0C}, false},
+	{"imagpart", [2]rune{0x2111}, false},
+	{"lbrack", [2]rune{0x005B}, false},
+	{"plusb", [2]rune{0x229E}, false},
+	{"NotGreaterLess", [2]rune{0x2279}, false},
+	{"sfrown", [2]rune{0x2322}, false},
+	{"sscr", [2]rune{0x1D4C8}, false},
+	{"vprop", [2]rune{0x221D}, false},
+	{"longrightarrow", [2]rune{0x27F6}, false},
+	{"Oslash", [2]rune{0x00D8}, true},
+	{"Supset", [2]rune{0x22D1}, false},
+	{"Ubrcy", [2]rune{0x040E}, false},
+	{"vartheta", [2]rune{0x03D1}, false},
+	{"mDDot", [2]rune{0x223A}, false},
+	{"IJlig", [2]rune{0x0132}, false},
+	{"DoubleLeftTee", [2]rune{0x2AE4}, false},
+	{"oplus", [2]rune{0x2295}, false},
+	{"xcup", [2]rune{0x22C3}, false},
+	{"GreaterGreater", [2]rune{0x2AA2}, false},
+	{"ifr", [2]rune{0x1D526}, false},
+	{"nsub", [2]rune{0x2284}, false},
+	{"boxHu", [2]rune{0x2567}, false},
+	{"loplus", [2]rune{0x2A2D}, false},
+	{"oopf", [2]rune{0x1D560}, false},
+	{"ratail", [2]rune{0x291A}, false},
+	{"icy", [2]rune{0x0438}, false},
+	{"mstpos", [2]rune{0x223E}, false},
+	{"succapprox", [2]rune{0x2AB8}, false},
+	{"CirclePlus", [2]rune{0x2295}, false},
+	{"qprime", [2]rune{0x2057}, false},
+	{"Succeeds", [2]rune{0x227B}, false},
+	{"emsp13", [2]rune{0x2004}, false},
+	{"Vcy", [2]rune{0x0412}, false},
+	{"eplus", [2]rune{0x2A71}, false},
+	{"blacktriangleleft", [2]rune{0x25C2}, false},
+	{"nharr", [2]rune{0x21AE}, false},
+	{"nopf", [2]rune{0x1D55F}, false},
+	{"Prime", [2]rune{0x2033}, false},
+	{"SmallCircle", [2]rune{0x2218}, false},
+	{"twixt", [2]rune{0x226C}, false},
+	{"euro", [2]rune{0x20AC}, false},
+	{"Downarrow", [2]rune{0x21D3}, false},
+	{"SquareSubset", [2]rune{0x228F}, false},
+	{"nvap", [2]rune{0x224D, 0x20D2}, false},
+	{"biguplus", [2]rune{0x2A04}, false},
+	{"nparallel", [2]rune{0x2226}, false},
+	{"lopf", [2]rune{0x1D55D}, false},
+	{"rscr", [2]rune{0x1D4C7}, false},
+	{"grave", [2]rune{0x0060}, false},
+	{"rtrif", [2]rune{0x25B8}, false},
+	{"lfisht", [2]rune{0x297C}, false},
+	{"eDDot", [2]rune{0x2A77}, false},
+	{"bigoplus", [2]rune{0x2A01}, false},
+	{"block", [2]rune{0x2588}, false},
+	{"subne", [2]rune{0x228A}, false},
+	{"gimel", [2]rune{0x2137}, false},
+	{"cire", [2]rune{0x2257}, false},
+	{"theta", [2]rune{0x03B8}, false},
+	{"dharl", [2]rune{0x21C3}, false},
+	{"nwarhk", [2]rune{0x2923}, false},
+	{"bigcap", [2]rune{0x22C2}, false},
+	{"rtrie", [2]rune{0x22B5}, false},
+	{"gvnE", [2]rune{0x2269, 0xFE00}, false},
+	{"Psi", [2]rune{0x03A8}, false},
+	{"isins", [2]rune{0x22F4}, false},
+	{"supnE", [2]rune{0x2ACC}, false},
+	{"backprime", [2]rune{0x2035}, false},
+	{"mopf", [2]rune{0x1D55E}, false},
+	{"num", [2]rune{0x0023}, false},
+	{"Conint", [2]rune{0x222F}, false},
+	{"lrhard", [2]rune{0x296D}, false},
+	{"apos", [2]rune{0x0027}, false},
+	{"qscr", [2]rune{0x1D4C6}, false},
+	{"circ", [2]rune{0x02C6}, false},
+	{"npreceq", [2]rune{0x2AAF, 0x0338}, false},
+	{"nshortparallel", [2]rune{0x2226}, false},
+	{"leftleftarrows", [2]rune{0x21C7}, false},
+	{"angmsdac", [2]rune{0x29AA}, false},
+	{"Omega", [2]rune{0x03A9}, false},
+	{"icirc", [2]rune{0x00EE}, true},
+	{"bbrktbrk", [2]rune{0x23B6}, false},
+	{"ShortDownArrow", [2]rune{0x2193}, false},
+	{"gcirc", [2]rune{0x011D}, false},
+	{"ne", [2]rune{0x2260}, false},
+	{"urcrop", [2]rune{0x230E}, false},
+	{"triangleleft", [2]rune{0x25C3}, false},
+	{"DoubleVerticalBar", [2]rune{0x2225}, false},
+	{"hArr", [2]rune{0x21D4}, false},
+	{"rtimes", [2]rune{0x22CA}, false},
+	{"operp", [2]rune{0x29B9}, false},
+	{"hyphen", [2]rune{0x2010}, false},
+	{"not", [2]rune{0x00AC}, true},
+	{"Vdashl", [2]rune{0x2AE6}, false},
+	{"RightVector", [2]rune{0x21C0}, false},
+	{"angmsdaf", [2]rune{0x29AD}, false},
+	{"ecirc", [2]rune{0x00EA}, true},
+	{"Epsilon", [2]rune{0x0395}, false},
+	{"uwangle", [2]rune{0x29A7}, false},
+	{"xnis", [2]rune{0x22FB}, false},
+	{"angmsdag", [2]rune{0x29AE}, false},
+	{"RightUpDownVector", [2]rune{0x294F}, false},
+	{"scnsim", [2]rune{0x22E9}, false},
+	{"epsiv", [2]rune{0x03F5}, false},
+	{"ulcrop", [2]rune{0x230F}, false},
+	{"isinv", [2]rune{0x2208}, false},
+	{"angmsdae", [2]rune{0x29AC}, false},
+	{"Ocy", [2]rune{0x041E}, false},
+	{"perp", [2]rune{0x22A5}, false},
+	{"hardcy", [2]rune{0x044A}, false},
+	{"dharr", [2]rune{0x21C2}, false},
+	{"NotTilde", [2]rune{0x2241}, false},
+	{"boxHD", [2]rune{0x2566}, false},
+	{"CHcy", [2]rune{0x0427}, false},
+	{"cuwed", [2]rune{0x22CF}, false},
+	{"lgE", [2]rune{0x2A91}, false},
+	{"InvisibleComma", [2]rune{0x2063}, false},
+	{"NotGreaterSlantEqual", [2]rune{0x2A7E, 0x0338}, false},
+	{"boxHU", [2]rune{0x2569}, false},
+	{"NotSucceedsSlantEqual", [2]rune{0x22E1}, false},
+	{"ltrif", [2]rune{0x25C2}, false},
+	{"leftharpoondown", [2]rune{0x21BD}, false},
+	{"kopf", [2]rune{0x1D55C}, false},
+	{"llhard", [2]rune{0x296B}, false},
+	{"Uuml", [2]rune{0x00DC}, true},
+	{"ltrie", [2]rune{0x22B4}, false},
+	{"approxeq", [2]rune{0x224A}, false},
+	{"kgreen", [2]rune{0x0138}, false},
+	{"bcy", [2]rune{0x0431}, false},
+	{"RightArrowLeftArrow", [2]rune{0x21C4}, false},
+	{"nsim", [2]rune{0x2241}, false},
+	{"Rarrtl", [2]rune{0x2916}, false},
+	{"ufr", [2]rune{0x1D532}, false},
+	{"lmoustache", [2]rune{0x23B0}, false},
+	{"angmsdaa", [2]rune{0x29A8}, false},
+	{"squf", [2]rune{0x25AA}, false},
+	{"le", [2]rune{0x2264}, false},
+	{"drbkarow", [2]rune{0x2910}, false},
+	{"leftarrowtail", [2]rune{0x21A2}, false},
+	{"bemptyv", [2]rune{0x29B0}, false},
+	{"OverParenthesis", [2]rune{0x23DC}, false},
+	{"angle", [2]rune{0x2220}, false},
+	{"emptyv", [2]rune{0x2205}, false},
+	{"rAtail", [2]rune{0x291C}, false},
+	{"empty", [2]rune{0x2205}, false},
+	{"lhard", [2]rune{0x21BD}, false},
+	{"Larr", [2]rune{0x219E}, false},
+	{"langle", [2]rune{0x27E8}, false},
+	{"lotimes", [2]rune{0x2A34}, false},
+	{"nlt", [2]rune{0x226E}, false},
+	{"bot", [2]rune{0x22A5}, false},
+	{"frac35", [2]rune{0x2157}, false},
+	{"prime", [2]rune{0x2032}, false},
+	{"Uogon", [2]rune{0x0172}, false},
+	{"OElig", [2]rune{0x0152}, false},
+	{"ucy", [2]rune{0x0443}, false},
+	{"nvge", [2]rune{0x2265, 0x20D2}, false},
+	{"rarrlp", [2]rune{0x21AC}, false},
+	{"bigtriangledown", [2]rune{0x25BD}, false},
+	{"NotGreaterEqual", [2]rune{0x2271}, false},
+	{"xdtri", [2]rune{0x25BD}, false},
+	{"subsup", [2]rune{0x2AD3}, false},
+	{"oscr", [2]rune{0x2134}, false},
+	{"prnap", [2]rune{0x2AB9}, false},
+	{"Vbar", [2]rune{0x2AEB}, false},
+	{"Lfr", [2]rune{0x1D50F}, false},
+	{"nearr", [2]rune{0x2197}, false},
+	{"Zdot", [2]rune{0x017B}, false},
+	{"capand", [2]rune{0x2A44}, false},
+	{"order", [2]rune{0x2134}, false},
+	{"eg", [2]rune{0x2A9A}, false},
+	{"Cross", [2]rune{0x2A2F}, false},
+	{"bbrk", [2]rune{0x23B5}, false},
+	{"larrbfs", [2]rune{0x291F}, false},
+	{"dtdot", [2]rune{0x22F1}, false},
+	{"el", [2]rune{0x2A99}, false},
+	{"NonBreakingSpace", [2]rune{0x00A0}, false},
+	{"eparsl", [2]rune{0x29E3}, false},
+	{"boxhD", [2]rune{0x2565}, false},
+	{"NotSquareSupersetEqual", [2]rune{0x22E3}, false},
+	{"frac34", [2]rune{0x00BE}, true},
+	{"dArr", [2]rune{0x21D3}, false},
+	{"sect", [2]rune{0x00A7}, true},
+	{"SquareSuperset", [2]rune{0x2290}, false},
+	{"odsold", [2]rune{0x29BC}, false},
+	{"star", [2]rune{0x2606}, false},
+	{"dtrif", [2]rune{0x25BE}, false},
+	{"TScy", [2]rune{0x0426}, false},
+	{"TSHcy", [2]rune{0x040B}, false},
+	{"LessTilde", [2]rune{0x2272}, false},
+	{"rbarr", [2]rune{0x290D}, false},
+	{"rotimes", [2]rune{0x2A35}, false},
+	{"jopf", [2]rune{0x1D55B}, false},
+	{"Longrightarrow", [2]rune{0x27F9}, false},
+	{"THORN", [2]rune{0x00DE}, true},
+	{"NotReverseElement", [2]rune{0x220C}, false},
+	{"Coproduct", [2]rune{0x2210}, false},
+	{"circlearrowright", [2]rune{0x21BB}, false},
+	{"ncap", [2]rune{0x2A43}, false},
+	{"subseteqq", [2]rune{0x2AC5}, false},
+	{"frown", [2]rune{0x2322}, false},
+	{"DiacriticalAcute", [2]rune{0x00B4}, false},
+	{"dcy", [2]rune{0x0434}, false},
+	{"ngeqq", [2]rune{0x2267, 0x0338}, false},
+	{"xmap", [2]rune{0x27FC}, false},
+	{"divide", [2]rune{0x00F7}, true},
+	{"nis", [2]rune{0x22FC}, false},
+	{"NotHumpDownHump", [2]rune{0x224E, 0x0338}, false},
+	{"Hfr", [2]rune{0x210C}, false},
+	{"nsce", [2]rune{0x2AB0, 0x0338}, false},
+	{"DownLeftVector", [2]rune{0x21BD}, false},
+	{"shchcy", [2]rune{0x0449}, false},
+	{"gnsim", [2]rune{0x22E7}, false},
+	{"NegativeThickSpace", [2]rune{0x200B}, false},
+	{"fnof", [2]rune{0x0192}, false},
+	{"female", [2]rune{0x2640}, false},
+	{"rarrbfs", [2]rune{0x2920}, false},
+	{"vsupne", [2]rune{0x228B, 0xFE00}, false},
+	{"boxhU", [2]rune{0x2568}, false},
+	{"mho", [2]rune{0x2127}, false},
+	{"nexist", [2]rune{0x2204}, false},
+	{"ReverseEquilibrium", [2]rune{0x21CB}, false},
+	{"mscr", [2]rune{0x1D4C2}, false},
+	{"varsubsetneq", [2]rune{0x228A, 0xFE00}, false},
+	{"yen", [2]rune{0x00A5}, true},
+	{"Rcaron", [2]rune{0x0158}, false},
+	{"ee", [2]rune{0x2147}, false},
+	{"ltdot", [2]rune{0x22D6}, false},
+	{"jukcy", [2]rune{0x0454}, false},
+	{"NotGreater", [2]rune{0x226F}, false},
+	{"Iogon", [2]rune{0x012E}, false},
+	{"upharpoonright", [2]rune{0x21BE}, false},
+	{"Eta", [2]rune{0x0397}, false},
+	{"nvrArr", [2]rune{0x2903}, false},
+	{"sqsubset", [2]rune{0x228F}, false},
+	{"Agrave", [2]rune{0x00C0}, true},
+	{"NotRightTriangleEqual", [2]rune{0x22ED}, false},
+	{"oint", [2]rune{0x222E}, false},
+	{"hookleftarrow", [2]rune{0x21A9}, false},
+	{"iopf", [2]rune{0x1D55A}, false},
+	{"ENG", [2]rune{0x014A}, false},
+	{"iff", [2]rune{0x21D4}, false},
+	{"SuchThat", [2]rune{0x220B}, false},
+	{"nexists", [2]rune{0x2204}, false},
+	{"oast", [2]rune{0x229B}, false},
+	{"nfr", [2]rune{0x1D52B}, false},
+	{"vartriangleleft", [2]rune{0x22B2}, false},
+	{"lscr", [2]rune{0x1D4C1}, false},
+	{"ddarr", [2]rune{0x21CA}, false},
+	{"sub", [2]rune{0x2282}, false},
+	{"pscr", [2]rune{0x1D4C5}, false},
+	{"mumap", [2]rune{0x22B8}, false},
+	{"NotNestedLessLess", [2]rune{0x2AA1, 0x0338}, false},
+	{"frac38", [2]rune{0x215C}, false},
+	{"rthree", [2]rune{0x22CC}, false},
+	{"sup", [2]rune{0x2283}, false},
+	{"multimap", [2]rune{0x22B8}, false},
+	{"sqsube", [2]rune{0x2291}, false},
+	{"emsp14", [2]rune{0x2005}, false},
+	{"tscy", [2]rune{0x0446}, false},
+	{"swarhk", [2]rune{0x2926}, false},
+	{"Lacute", [2]rune{0x0139}, false},
+	{"nle", [2]rune{0x2270}, false},
+	{"rmoustache", [2]rune{0x23B1}, false},
+	{"angmsdah", [2]rune{0x29AF}, false},
+	{"varsigma", [2]rune{0x03C2}, false},
+	{"nrarrw", [2]rune{0x219D, 0x0338}, false},
+	{"bull", [2]rune{0x2022}, false},
+	{"nearhk", [2]rune{0x2924}, false},
+	{"Ycirc", [2]rune{0x0176}, false},
+	{"topbot", [2]rune{0x2336}, false},
+	{"nhpar", [2]rune{0x2AF2}, false},
+	{"acirc", [2]rune{0x00E2}, true},
+	{"upuparrows", [2]rune{0x21C8}, false},
+	{"curren", [2]rune{0x00A4}, true},
+	{"raemptyv", [2]rune{0x29B3}, false},
+	{"primes", [2]rune{0x2119}, false},
+	{"Wcirc", [2]rune{0x0174}, false},
+	{"sung", [2]rune{0x266A}, false},
+	{"bnot", [2]rune{0x2310}, false},
+	{"varrho", [2]rune{0x03F1}, false},
+	{"pluse", [2]rune{0x2A72}, false},
+	{"Sacute", [2]rune{0x015A}, false},
+	{"sum", [2]rune{0x2211}, false},
+	{"uring", [2]rune{0x016F}, false},
+	{"Eogon", [2]rune{0x0118}, false},
+	{"lsim", [2]rune{0x2272}, false},
+	{"integers", [2]rune{0x2124}, false},
+	{"Scirc", [2]rune{0x015C}, false},
+	{"NewLine", [2]rune{0x000A}, false},
+	{"AElig", [2]rune{0x00C6}, true},
+	{"boxminus", [2]rune{0x229F}, false},
+	{"seArr", [2]rune{0x21D8}, false},
+	{"dstrok", [2]rune{0x0111}, false},
+	{"veeeq", [2]rune{0x225A}, false},
+	{"LeftDownVectorBar", [2]rune{0x2959}, false},
+	{"Because", [2]rune{0x2235}, false},
+	{"awconint", [2]rune{0x2233}, false},
+	{"hopf", [2]rune{0x1D559}, false},
+	{"Acy", [2]rune{0x0410}, false},
+	{"RightTriangleEqual", [2]rune{0x22B5}, false},
+	{"nrightarrow", [2]rune{0x219B}, false},
+	{"hkswarow", [2]rune{0x2926}, false},
+	{"Lstrok", [2]rune{0x0141}, false},
+	{"Afr", [2]rune{0x1D504}, false},
+	{"CounterClockwiseContourIntegral", [2]rune{0x2233}, false},
+	{"gopf", [2]rune{0x1D558}, false},
+	{"Aogon", [2]rune{0x0104}, false},
+	{"natur", [2]rune{0x266E}, false},
+	{"erDot", [2]rune{0x2253}, false},
+	{"harrcir", [2]rune{0x2948}, false},
+	{"profsurf", [2]rune{0x2313}, false},
+	{"gbreve", [2]rune{0x011F}, false},
+	{"RightDownVector", [2]rune{0x21C2}, false},
+	{"lbrace", [2]rune{0x007B}, false},
+	{"rho", [2]rune{0x03C1}, false},
+	{"suphsol", [2]rune{0x27C9}, false},
+	{"precneqq", [2]rune{0x2AB5}, false},
+	{"VerticalBar", [2]rune{0x2223}, false},
+	{"glj", [2]rune{0x2AA4}, false},
+	{"Tfr", [2]rune{0x1D517}, false},
+	{"NotLessSlantEqual", [2]rune{0x2A7D, 0x0338}, false},
+	{"RightDoubleBracket", [2]rune{0x27E7}, false},
+	{"qint", [2]rune{0x2A0C}, false},
+	{"gla", [2]rune{0x2AA5}, false},
+	{"osol", [2]rune{0x2298}, false},
+	{"subnE", [2]rune{0x2ACB}, false},
+	{"timesb", [2]rune{0x22A0}, false},
+	{"kscr", [2]rune{0x1D4C0}, false},
+	{"ccaron", [2]rune{0x010D}, false},
+	{"supdsub", [2]rune{0x2AD8}, false},
+	{"sqcup", [2]rune{0x2294}, false},
+	{"Tcy", [2]rune{0x0422}, false},
+	{"NotCongruent", [2]rune{0x2262}, false},
+	{"Ouml", [2]rune{0x00D6}, true},
+	{"utilde", [2]rune{0x0169}, false},
+	{"nLeftrightarrow", [2]rune{0x21CE}, false},
+	{"vangrt", [2]rune{0x299C}, false},
+	{"timesd", [2]rune{0x2A30}, false},
+	{"ccaps", [2]rune{0x2A4D}, false},
+	{"nsqsube", [2]rune{0x22E2}, false},
+	{"bowtie", [2]rune{0x22C8}, false},
+	{"boxhd", [2]rune{0x252C}, false},
+	{"boxVr", [2]rune{0x255F}, false},
+	{"permil", [2]rune{0x2030}, false},
+	{"geqslant", [2]rune{0x2A7E}, false},
+	{"DownArrow", [2]rune{0x2193}, false},
+	{"bottom", [2]rune{0x22A5}, false},
+	{"divonx", [2]rune{0x22C7}, false},
+	{"delta", [2]rune{0x03B4}, false},
+	{"rarrfs", [2]rune{0x291E}, false},
+	{"preccurlyeq", [2]rune{0x227C}, false},
+	{"NotLeftTriangleEqual", [2]rune{0x22EC}, false},
+	{"mlcp", [2]rune{0x2ADB}, false},
+	{"SucceedsEqual", [2]rune{0x2AB0}, false},
+	{"SquareSupersetEqual", [2]rune{0x2292}, false},
+	{"quot", [2]rune{0x0022}, true},
+	{"InvisibleTimes", [2]rune{0x2062}, false},
+	{"nvrtrie", [2]rune{0x22B5, 0x20D2}, false},
+	{"gcy", [2]rune{0x0433}, false},
+	{"Ocirc", [2]rune{0x00D4}, true},
+	{"nwarrow", [2]rune{0x2196}, false},
+	{"itilde", [2]rune{0x0129}, false},
+	{"ForAll", [2]rune{0x2200}, false},
+	{"fopf", [2]rune{0x1D557}, false},
+	{"isinE", [2]rune{0x22F9}, false},
+	{"nLl", [2]rune{0x22D8, 0x0338}, false},
+	{"emptyset", [2]rune{0x2205}, false},
+	{"wedgeq", [2]rune{0x2259}, false},
+	{"asymp", [2]rune{0x2248}, false},
+	{"niv", [2]rune{0x220B}, false},
+	{"napE", [2]rune{0x2A70, 0x0338}, false},
+	{"apid", [2]rune{0x224B}, false},
+	{"suphsub", [2]rune{0x2AD7}, false},
+	{"notniva", [2]rune{0x220C}, false},
+	{"jscr", [2]rune{0x1D4BF}, false},
+	{"ntgl", [2]rune{0x2279}, false},
+	{"boxVl", [2]rune{0x2562}, false},
+	{"triangleq", [2]rune{0x225C}, false},
+	{"varnothing", [2]rune{0x2205}, false},
+	{"zcy", [2]rune{0x0437}, false},
+	{"prnsim", [2]rune{0x22E8}, false},
+	{"copy", [2]rune{0x00A9}, true},
+	{"oline", [2]rune{0x203E}, false},
+	{"ast", [2]rune{0x002A}, false},
+	{"nsubseteqq", [2]rune{0x2AC5, 0x0338}, false},
+	{"dashv", [2]rune{0x22A3}, false},
+	{"subsub", [2]rune{0x2AD5}, false},
+	{"zigrarr", [2]rune{0x21DD}, false},
+	{"lbarr", [2]rune{0x290C}, false},
+	{"dzigrarr", [2]rune{0x27FF}, false},
+	{"curvearrowleft", [2]rune{0x21B6}, false},
+	{"nlE", [2]rune{0x2266, 0x0338}, false},
+	{"backepsilon", [2]rune{0x03F6}, false},
+	{"csup", [2]rune{0x2AD0}, false},
+	{"LeftCeiling", [2]rune{0x2308}, false},
+	{"nrarrc", [2]rune{0x2933, 0x0338}, false},
+	{"cap", [2]rune{0x2229}, false},
+	{"prurel", [2]rune{0x22B0}, false},
+	{"subsim", [2]rune{0x2AC7}, false},
+	{"DDotrahd", [2]rune{0x2911}, false},
+	{"there4", [2]rune{0x2234}, false},
+	{"rightleftarrows", [2]rune{0x21C4}, false},
+	{"rcub", [2]rune{0x007D}, false},
+	{"diamond", [2]rune{0x22C4}, false},
+	{"reg", [2]rune{0x00AE}, true},
+	{"eopf", [2]rune{0x1D556}, false},
+	{"ominus", [2]rune{0x2296}, false},
+	{"sol", [2]rune{0x002F}, false},
+	{"ssetmn", [2]rune{0x2216}, false},
+	{"Darr", [2]rune{0x21A1}, false},
+	{"Mcy", [2]rune{0x041C}, false},
+	{"Subset", [2]rune{0x22D0}, false},
+	{"equivDD", [2]rune{0x2A78}, false},
+	{"squarf", [2]rune{0x25AA}, false},
+	{"swarr", [2]rune{0x2199}, false},
+	{"NotEqualTilde", [2]rune{0x2242, 0x0338}, false},
+	{"tcaron", [2]rune{0x0165}, false},
+	{"Leftarrow", [2]rune{0x21D0}, false},
+	{"Uring", [2]rune{0x016E}, false},
+	{"Pr", [2]rune{0x2ABB}, false},
+	{"vsubne", [2]rune{0x228A, 0xFE00}, false},
+	{"eqcolon", [2]rune{0x2255}, false},
+	{"searhk", [2]rune{0x2925}, false},
+	{"Icirc", [2]rune{0x00CE}, true},
+	{"dagger", [2]rune{0x2020}, false},
+	{"intcal", [2]rune{0x22BA}, false},
+	{"zhcy", [2]rune{0x0436}, false},
+	{"hamilt", [2]rune{0x210B}, false},
+	{"ffllig", [2]rune{0xFB04}, false},
+	{"notnivc", [2]rune{0x22FD}, false},
+	{"nRightarrow", [2]rune{0x21CF}, false},
+	{"DiacriticalDot", [2]rune{0x02D9}, false},
+	{"Mfr", [2]rune{0x1D510}, false},
+	{"nvDash", [2]rune{0x22AD}, false},
+	{"ogt", [2]rune{0x29C1}, false},
+	{"LeftUpDownVector", [2]rune{0x2951}, false},
+	{"DoubleUpArrow", [2]rune{0x21D1}, false},
+	{"gsim", [2]rune{0x2273}, false},
+	{"FilledVerySmallSquare", [2]rune{0x25AA}, false},
+	{"ring", [2]rune{0x02DA}, false},
+	{"rarrhk", [2]rune{0x21AA}, false},
+	{"dopf", [2]rune{0x1D555}, false},
+	{"upsilon", [2]rune{0x03C5}, false},
+	{"ijlig", [2]rune{0x0133}, false},
+	{"cupcap", [2]rune{0x2A46}, false},
+	{"LongLeftArrow", [2]rune{0x27F5}, false},
+	{"angmsd", [2]rune{0x2221}, false},
+	{"sfr", [2]rune{0x1D530}, false},
+	{"vsupnE", [2]rune{0x2ACC, 0xFE00}, false},
+	{"supsetneqq", [2]rune{0x2ACC}, false},
+	{"iacute", [2]rune{0x00ED}, true},
+	{"ShortLeftArrow", [2]rune{0x2190}, false},
+	{"gtcir", [2]rune{0x2A7A}, false},
+	{"ecolon", [2]rune{0x2255}, false},
+	{"zeta", [2]rune{0x03B6}, false},
+	{"Acirc", [2]rune{0x00C2}, true},
+	{"uacute", [2]rune{0x00FA}, true},
+	{"ccedil", [2]rune{0x00E7}, true},
+	{"lrm", [2]rune{0x200E}, false},
+	{"ntilde", [2]rune{0x00F1}, true},
+	{"bigcup", [2]rune{0x22C3}, false},
+	{"csube", [2]rune{0x2AD1}, false},
+	{"Fouriertrf", [2]rune{0x2131}, false},
+	{"Integral", [2]rune{0x222B}, false},
+	{"sube", [2]rune{0x2286}, false},
+	{"rsqb", [2]rune{0x005D}, false},
+	{"orderof", [2]rune{0x2134}, false},
+	{"ratio", [2]rune{0x2236}, false},
+	{"NotPrecedes", [2]rune{0x2280}, false},
+	{"sim", [2]rune{0x223C}, false},
+	{"Ccirc", [2]rune{0x0108}, false},
+	{"comp", [2]rune{0x2201}, false},
+	{"nwArr", [2]rune{0x21D6}, false},
+	{"thetasym", [2]rune{0x03D1}, false},
+	{"NotSquareSubsetEqual", [2]rune{0x22E2}, false},
+	{"loz", [2]rune{0x25CA}, false},
+	{"dsol", [2]rune{0x29F6}, false},
+	{"dzcy", [2]rune{0x045F}, false},
+	{"csub", [2]rune{0x2ACF}, false},
+	{"ngtr", [2]rune{0x226F}, false},
+	{"nleftrightarrow", [2]rune{0x21AE}, false},
+	{"subE", [2]rune{0x2AC5}, false},
+	{"breve", [2]rune{0x02D8}, false},
+	{"imped", [2]rune{0x01B5}, false},
+	{"angmsdad", [2]rune{0x29AB}, false},
+	{"PrecedesEqual", [2]rune{0x2AAF}, false},
+	{"Jukcy", [2]rune{0x0404}, false},
+	{"scy", [2]rune{0x0441}, false},
+	{"comma", [2]rune{0x002C}, false},
+	{"uuarr", [2]rune{0x21C8}, false},
+	{"gesdotol", [2]rune{0x2A84}, false},
+	{"homtht", [2]rune{0x223B}, false},
+	{"NotExists", [2]rune{0x2204}, false},
+	{"xodot", [2]rune{0x2A00}, false},
+	{"ReverseElement", [2]rune{0x220B}, false},
+	{"NotLessTilde", [2]rune{0x2274}, false},
+	{"isindot", [2]rune{0x22F5}, false},
+	{"Ascr", [2]rune{0x1D49C}, false},
+	{"Pi", [2]rune{0x03A0}, false},
+	{"trpezium", [2]rune{0x23E2}, false},
+	{"rect", [2]rune{0x25AD}, false},
+	{"kappav", [2]rune{0x03F0}, false},
+	{"scnE", [2]rune{0x2AB6}, false},
+	{"thetav", [2]rune{0x03D1}, false},
+	{"Dcaron", [2]rune{0x010E}, false},
+	{"Beta", [2]rune{0x0392}, false},
+	{"Kcedil", [2]rune{0x0136}, false},
+	{"amp", [2]rune{0x0026}, true},
+	{"bigsqcup", [2]rune{0x2A06}, false},
+	{"Sc", [2]rune{0x2ABC}, false},
+	{"ccirc", [2]rune{0x0109}, false},
+	{"ange", [2]rune{0x29A4}, false},
+	{"ograve", [2]rune{0x00F2}, true},
+	{"fscr", [2]rune{0x1D4BB}, false},
+	{"exponentiale", [2]rune{0x2147}, false},
+	{"NotSupersetEqual", [2]rune{0x2289}, false},
+	{"ncy", [2]rune{0x043D}, false},
+	{"HARDcy", [2]rune{0x042A}, false},
+	{"xuplus", [2]rune{0x2A04}, false},
+	{"ape", [2]rune{0x224A}, false},
+	{"aopf", [2]rune{0x1D552}, false},
+	{"bepsi", [2]rune{0x03F6}, false},
+	{"reals", [2]rune{0x211D}, false},
+	{"Colon", [2]rune{0x2237}, false},
+	{"leftrightarrows", [2]rune{0x21C6}, false},
+	{"VerticalTilde", [2]rune{0x2240}, false},
+	{"semi", [2]rune{0x003B}, false},
+	{"ngeqslant", [2]rune{0x2A7E, 0x0338}, false},
+	{"Ycy", [2]rune{0x042B}, false},
+	{"Ffr", [2]rune{0x1D509}, false},
+	{"intprod", [2]rune{0x2A3C}, false},
+	{"esim", [2]rune{0x2242}, false},
+	{"Barv", [2]rune{0x2AE7}, false},
+	{"Yfr", [2]rune{0x1D51C}, false},
+	{"TildeTilde", [2]rune{0x2248}, false},
+	{"lesdoto", [2]rune{0x2A81}, false},
+	{"percnt", [2]rune{0x0025}, false},
+	{"nless", [2]rune{0x226E}, false},
+	{"Gt", [2]rune{0x226B}, false},
+	{"supset", [2]rune{0x2283}, false},
+	{"Fcy", [2]rune{0x0424}, false},
+	{"bopf", [2]rune{0x1D553}, false},
+	{"notnivb", [2]rune{0x22FE}, false},
+	{"sstarf", [2]rune{0x22C6}, false},
+	{"glE", [2]rune{0x2A92}, false},
+	{"Delta", [2]rune{0x0394}, false},
+	{"alpha", [2]rune{0x03B1}, false},
+	{"roang", [2]rune{0x27ED}, false},
+	{"Theta", [2]rune{0x0398}, false},
+	{"els", [2]rune{0x2A95}, false},
+	{"starf", [2]rune{0x2605}, false},
+	{"triangleright", [2]rune{0x25B9}, false},
+	{"scsim", [2]rune{0x227F}, false},
+	{"RightTriangle", [2]rune{0x22B3}, false},
+	{"bsol", [2]rune{0x005C}, false},
+	{"nvlArr", [2]rune{0x2902}, false},
+	{"notni", [2]rune{0x220C}, false},
+	{"ncup", [2]rune{0x2A42}, false},
+	{"CircleTimes", [2]rune{0x2297}, false},
+	{"PlusMinus", [2]rune{0x00B1}, false},
+	{"boxvr", [2]rune{0x251C}, false},
+	{"gscr", [2]rune{0x210A}, false},
+	{"upsi", [2]rune{0x03C5}, false},
+	{"nbump", [2]rune{0x224E, 0x0338}, false},
+	{"ffilig", [2]rune{0xFB03}, false},
+	{"escr", [2]rune{0x212F}, false},
+	{"SucceedsTilde", [2]rune{0x227F}, false},
+	{"succnsim", [2]rune{0x22E9}, false},
+	{"ccupssm", [2]rune{0x2A50}, false},
+	{"rdsh", [2]rune{0x21B3}, false},
+	{"rsquor", [2]rune{0x2019}, false},
+	{"propto", [2]rune{0x221D}, false},
+	{"jmath", [2]rune{0x0237}, false},
+	{"exist", [2]rune{0x2203}, false},
+	{"RightTee", [2]rune{0x22A2}, false},
+	{"yacy", [2]rune{0x044F}, false},
+	{"dash", [2]rune{0x2010}, false},
+	{"curlyeqsucc", [2]rune{0x22DF}, false},
+	{"mdash", [2]rune{0x2014}, false},
+	{"boxVH", [2]rune{0x256C}, false},
+	{"varr", [2]rune{0x2195}, false},
+	{"odash", [2]rune{0x229D}, false},
+	{"hksearow", [2]rune{0x2925}, false},
+	{"lfr", [2]rune{0x1D529}, false},
+	{"dscy", [2]rune{0x0455}, false},
+	{"setmn", [2]rune{0x2216}, false},
+	{"PartialD", [2]rune{0x2202}, false},
+	{"upharpoonleft", [2]rune{0x21BF}, false},
+	{"loang", [2]rune{0x27EC}, false},
+	{"square", [2]rune{0x25A1}, false},
+	{"int", [2]rune{0x222B}, false},
+	{"deg", [2]rune{0x00B0}, true},
+	{"solb", [2]rune{0x29C4}, false},
+	{"gtrless", [2]rune{0x2277}, false},
+	{"twoheadrightarrow", [2]rune{0x21A0}, false},
+	{"gammad", [2]rune{0x03DD}, false},
+	{"boxVR", [2]rune{0x2560}, false},
+	{"lurdshar", [2]rune{0x294A}, false},
+	{"lnapprox", [2]rune{0x2A89}, false},
+	{"Iuml", [2]rune{0x00CF}, true},
+	{"sce", [2]rune{0x2AB0}, false},
+	{"ocir", [2]rune{0x229A}, false},
+	{"sqcaps", [2]rune{0x2293, 0xFE00}, false},
+	{"njcy", [2]rune{0x045A}, false},
+	{"nsccue", [2]rune{0x22E1}, false},
+	{"UnderBar", [2]rune{0x005F}, false},
+	{"boxvH", [2]rune{0x256A}, false},
+	{"mapstodown", [2]rune{0x21A7}, false},
+	{"smeparsl", [2]rune{0x29E4}, false},
+	{"capcap", [2]rune{0x2A4B}, false},
+	{"rhov", [2]rune{0x03F1}, false},
+	{"rightleftharpoons", [2]rune{0x21CC}, false},
+	{"lesges", [2]rune{0x2A93}, false},
+	{"ltquest", [2]rune{0x2A7B}, false},
+	{"prap", [2]rune{0x2AB7}, false},
+	{"curvearrowright", [2]rune{0x21B7}, false},
+	{"COPY", [2]rune{0x00A9}, true},
+	{"maltese", [2]rune{0x2720}, false},
+	{"nvlt", [2]rune{0x003C, 0x20D2}, false},
+	{"SHCHcy", [2]rune{0x0429}, false},
+	{"pi", [2]rune{0x03C0}, false},
+	{"srarr", [2]rune{0x2192}, false},
+	{"nsupset", [2]rune{0x2283, 0x20D2}, false},
+	{"varphi", [2]rune{0x03D5}, false},
+	{"rarrw", [2]rune{0x219D}, false},
+	{"middot", [2]rune{0x00B7}, true},
+	{"gtreqless", [2]rune{0x22DB}, false},
+	{"hscr", [2]rune{0x1D4BD}, false},
+	{"boxvh", [2]rune{0x253C}, false},
+	{"longleftrightarrow", [2]rune{0x27F7}, false},
+	{"dscr", [2]rune{0x1D4B9}, false},
+	{"udhar", [2]rune{0x296E}, false},
+	{"cscr", [2]rune{0x1D4B8}, false},
+	{"varsubsetneqq", [2]rune{0x2ACB, 0xFE00}, false},
+	{"lcub", [2]rune{0x007B}, false},
+	{"caps", [2]rune{0x2229, 0xFE00}, false},
+	{"emsp", [2]rune{0x2003}, false},
+	{"phone", [2]rune{0x260E}, false},
+	{"plustwo", [2]rune{0x2A27}, false},
+	{"bsim", [2]rune{0x223D}, false},
+	{"caret", [2]rune{0x2041}, false},
+	{"vsubnE", [2]rune{0x2ACB, 0xFE00}, false},
+	{"eqsim", [2]rune{0x2242}, false},
+	{"oelig", [2]rune{0x0153}, false},
+	{"eqvparsl", [2]rune{0x29E5}, false},
+	{"zfr", [2]rune{0x1D537}, false},
+	{"robrk", [2]rune{0x27E7}, false},
+	{"boxVL", [2]rune{0x2563}, false},
+	{"precapprox", [2]rune{0x2AB7}, false},
+	{"nacute", [2]rune{0x0144}, false},
+	{"zacute", [2]rune{0x017A}, false},
+	{"Aring", [2]rune{0x00C5}, true},
+	{"Rfr", [2]rune{0x211C}, false},
+	{"NotLessGreater", [2]rune{0x2278}, false},
+	{"topfork", [2]rune{0x2ADA}, false},
+	{"parsl", [2]rune{0x2AFD}, false},
+	{"seswar", [2]rune{0x2929}, false},
+	{"EmptySmallSquare", [2]rune{0x25FB}, false},
+	{"bullet", [2]rune{0x2022}, false},
+	{"isin", [2]rune{0x2208}, false},
+	{"orarr", [2]rune{0x21BB}, false},
+	{"uarr", [2]rune{0x2191}, false},
+	{"PrecedesSlantEqual", [2]rune{0x227C}, false},
+	{"urtri", [2]rune{0x25F9}, false},
+	{"cudarrl", [2]rune{0x2938}, false},
+	{"rightharpoonup", [2]rune{0x21C0}, false},
+	{"sigmav", [2]rune{0x03C2}, false},
+	{"ell", [2]rune{0x2113}, false},
+	{"Ucirc", [2]rune{0x00DB}, true},
+	{"Rcy", [2]rune{0x0420}, false},
+	{"angmsdab", [2]rune{0x29A9}, false},
+	{"boxDl", [2]rune{0x2556}, false},
+	{"iiint", [2]rune{0x222D}, false},
+	{"naturals", [2]rune{0x2115}, false},
+	{"Abreve", [2]rune{0x0102}, false},
+	{"ldquor", [2]rune{0x201E}, false},
+	{"iquest", [2]rune{0x00BF}, true},
+	{"sbquo", [2]rune{0x201A}, false},
+	{"UnderBrace", [2]rune{0x23DF}, false},
+	{"efr", [2]rune{0x1D522}, false},
+	{"xrArr", [2]rune{0x27F9}, false},
+	{"ljcy", [2]rune{0x0459}, false},
+	{"gesles", [2]rune{0x2A94}, false},
+	{"dwangle", [2]rune{0x29A6}, false},
+	{"HumpDownHump", [2]rune{0x224E}, false},
+	{"SubsetEqual", [2]rune{0x2286}, false},
+	{"nrArr", [2]rune{0x21CF}, false},
+	{"iscr", [2]rune{0x1D4BE}, false},
+	{"pr", [2]rune{0x227A}, false},
+	{"Dashv", [2]rune{0x2AE4}, false},
+	{"Gg", [2]rune{0x22D9}, false},
+	{"Otilde", [2]rune{0x00D5}, true},
+	{"nvltrie", [2]rune{0x22B4, 0x20D2}, false},
+	{"doteq", [2]rune{0x2250}, false},
+	{"LeftTeeVector", [2]rune{0x295A}, false},
+	{"ordm", [2]rune{0x00BA}, true},
+	{"ecy", [2]rune{0x044D}, false},
+	{"Rcedil", [2]rune{0x0156}, false},
+	{"larrpl", [2]rune{0x2939}, false},
+	{"apE", [2]rune{0x2A70}, false},
+	{"Chi", [2]rune{0x03A7}, false},
+	{"swnwar", [2]rune{0x292A}, false},
+	{"NotSuperset", [2]rune{0x2283, 0x20D2}, false},
+	{"Dot", [2]rune{0x00A8}, false},
+	{"LessGreater", [2]rune{0x2276}, false},
+	{"RightAngleBracket", [2]rune{0x27E9}, false},
+	{"nges", [2]rune{0x2A7E, 0x0338}, false},
+	{"NotTildeEqual", [2]rune{0x2244}, false},
+	{"bigtriangleup", [2]rune{0x25B3}, false},
+	{"nvle", [2]rune{0x2264, 0x20D2}, false},
+	{"ShortRightArrow", [2]rune{0x2192}, false},
+	{"kjcy", [2]rune{0x045C}, false},
+	{"therefore", [2]rune{0x2234}, false},
+	{"olcir", [2]rune{0x29BE}, false},
+	{"check", [2]rune{0x2713}, false},
+	{"harrw", [2]rune{0x21AD}, false},
+	{"tau", [2]rune{0x03C4}, false},
+	{"egrave", [2]rune{0x00E8}, true},
+	{"larrsim", [2]rune{0x2973}, false},
+	{"vDash", [2]rune{0x22A8}, false},
+	{"rarrc", [2]rune{0x2933}, false},
+	{"cwconint", [2]rune{0x2232}, false},
+	{"pm", [2]rune{0x00B1}, false},
+	{"xotime", [2]rune{0x2A02}, false},
+	{"backsimeq", [2]rune{0x22CD}, false},
+	{"vellip", [2]rune{0x22EE}, false},
+	{"ffr", [2]rune{0x1D523}, false},
+	{"gescc", [2]rune{0x2AA9}, false},
+	{"nLeftarrow", [2]rune{0x21CD}, false},
+	{"LeftUpVectorBar", [2]rune{0x2958}, false},
+	{"fltns", [2]rune{0x25B1}, false},
+	{"rarrb", [2]rune{0x21E5}, false},
+	{"nVDash", [2]rune{0x22AF}, false},
+	{"sc", [2]rune{0x227B}, false},
+	{"ordf", [2]rune{0x00AA}, true},
+	{"subrarr", [2]rune{0x2979}, false},
+	{"range", [2]rune{0x29A5}, false},
+	{"Rang", [2]rune{0x27EB}, false},
+	{"LeftArrow", [2]rune{0x2190}, false},
+	{"lneq", [2]rune{0x2A87}, false},
+	{"DoubleLongRightArrow", [2]rune{0x27F9}, false},
+	{"lcy", [2]rune{0x043B}, false},
+	{"dbkarow", [2]rune{0x290F}, false},
+	{"ropar", [2]rune{0x2986}, false},
+	{"micro", [2]rune{0x00B5}, true},
+	{"mu", [2]rune{0x03BC}, false},
+	{"lparlt", [2]rune{0x2993}, false},
+	{"eDot", [2]rune{0x2251}, false},
+	{"Kfr", [2]rune{0x1D50E}, false},
+	{"Euml", [2]rune{0x00CB}, true},
+	{"CupCap", [2]rune{0x224D}, false},
+	{"Cacute", [2]rune{0x0106}, false},
+	{"bsemi", [2]rune{0x204F}, false},
+	{"sqsupe", [2]rune{0x2292}, false},
+	{"Kcy", [2]rune{0x041A}, false},
+	{"lharul", [2]rune{0x296A}, false},
+	{"rtri", [2]rune{0x25B9}, false},
+	{"nedot", [2]rune{0x2250, 0x0338}, false},
+	{"GT", [2]rune{0x003E}, true},
+	{"LongLeftRightArrow", [2]rune{0x27F7}, false},
+	{"mapstoup", [2]rune{0x21A5}, false},
+	{"isinsv", [2]rune{0x22F3}, false},
+	{"rarrsim", [2]rune{0x2974}, false},
+	{"ngeq", [2]rune{0x2271}, false},
+	{"boxvR", [2]rune{0x255E}, false},
+	{"rightarrowtail", [2]rune{0x21A3}, false},
+	{"clubsuit", [2]rune{0x2663}, false},
+	{"phiv", [2]rune{0x03D5}, false},
+	{"erarr", [2]rune{0x2971}, false},
+	{"rarr", [2]rune{0x2192}, false},
+	{"gacute", [2]rune{0x01F5}, false},
+	{"bigotimes", [2]rune{0x2A02}, false},
+	{"searrow", [2]rune{0x2198}, false},
+	{"equals", [2]rune{0x003D}, false},
+	{"Otimes", [2]rune{0x2A37}, false},
+	{"Breve", [2]rune{0x02D8}, false},
+	{"sacute", [2]rune{0x015B}, false},
+	{"iinfin", [2]rune{0x29DC}, false},
+	{"notinvc", [2]rune{0x22F6}, false},
+	{"yuml", [2]rune{0x00FF}, true},
+	{"clubs", [2]rune{0x2663}, false},
+	{"lsaquo", [2]rune{0x2039}, false},
+	{"crarr", [2]rune{0x21B5}, false},
+	{"Vee", [2]rune{0x22C1}, false},
+	{"Lambda", [2]rune{0x039B}, false},
+	{"conint", [2]rune{0x222E}, false},
+	{"doteqdot", [2]rune{0x2251}, false},
+	{"boxvL", [2]rune{0x2561}, false},
+	{"fallingdotseq", [2]rune{0x2252}, false},
+	{"VeryThinSpace", [2]rune{0x200A}, false},
+	{"OpenCurlyQuote", [2]rune{0x2018}, false},
+	{"dotplus", [2]rune{0x2214}, false},
+	{"oslash", [2]rune{0x00F8}, true},
+	{"gtrsim", [2]rune{0x2273}, false},
+	{"nvsim", [2]rune{0x223C, 0x20D2}, false},
+	{"RightUpTeeVector", [2]rune{0x295C}, false},
+	{"gt", [2]rune{0x003E}, true},
+	{"lesdotor", [2]rune{0x2A83}, false},
+	{"tprime", [2]rune{0x2034}, false},
+	{"bne", [2]rune{0x003D, 0x20E5}, false},
+	{"longmapsto", [2]rune{0x27FC}, false},
+	{"coloneq", [2]rune{0x2254}, false},
+	{"qfr", [2]rune{0x1D52E}, false},
+	{"scE", [2]rune{0x2AB4}, false},
+	{"compfn", [2]rune{0x2218}, false},
+	{"Alpha", [2]rune{0x0391}, false},
+	{"Iota", [2]rune{0x0399}, false},
+	{"aelig", [2]rune{0x00E6}, true},
+	{"VerticalLine", [2]rune{0x007C}, false},
+	{"boxvl", [2]rune{0x2524}, false},
+	{"Rrightarrow", [2]rune{0x21DB}, false},
+	{"curlyeqprec", [2]rune{0x22DE}, false},
+	{"boxDR", [2]rune{0x2554}, false},
+	{"ldsh", [2]rune{0x21B2}, false},
+	{"ThickSpace", [2]rune{0x205F, 0x200A}, false},
+	{"Eacute", [2]rune{0x00C9}, true},
+	{"xlArr", [2]rune{0x27F8}, false},
+	{"rarrtl", [2]rune{0x21A3}, false},
+	{"efDot", [2]rune{0x2252}, false},
+	{"ltrPar", [2]rune{0x2996}, false},
+	{"copysr", [2]rune{0x2117}, false},
+	{"blank", [2]rune{0x2423}, false},
+	{"DoubleLongLeftArrow", [2]rune{0x27F8}, false},
+	{"nsucc", [2]rune{0x2281}, false},
+	{"excl", [2]rune{0x0021}, false},
+	{"Oacute", [2]rune{0x00D3}, true},
+	{"Mu", [2]rune{0x039C}, false},
+	{"Dfr", [2]rune{0x1D507}, false},
+	{"rfloor", [2]rune{0x230B}, false},
+	{"part", [2]rune{0x2202}, false},
+	{"ZeroWidthSpace", [2]rune{0x200B}, false},
+	{"centerdot", [2]rune{0x00B7}, false},
+	{"nleq", [2]rune{0x2270}, false},
+	{"boxdr", [2]rune{0x250C}, false},
+	{"lacute", [2]rune{0x013A}, false},
+	{"DotDot", [2]rune{0x20DC}, false},
+	{"nsqsupe", [2]rune{0x22E3}, false},
+	{"bscr", [2]rune{0x1D4B7}, false},
+	{"rangd", [2]rune{0x2992}, false},
+	{"acute", [2]rune{0x00B4}, true},
+	{"frac58", [2]rune{0x215D}, false},
+	{"lesssim", [2]rune{0x2272}, false},
+	{"xfr", [2]rune{0x1D535}, false},
+	{"simgE", [2]rune{0x2AA0}, false},
+	{"DotEqual", [2]rune{0x2250}, false},
+	{"NotEqual", [2]rune{0x2260}, false},
+	{"para", [2]rune{0x00B6}, true},
+	{"ap", [2]rune{0x2248}, false},
+	{"weierp", [2]rune{0x2118}, false},
+	{"leftrightsquigarrow", [2]rune{0x21AD}, false},
+	{"cupcup", [2]rune{0x2A4A}, false},
+	{"xsqcup", [2]rune{0x2A06}, false},
+	{"map", [2]rune{0x21A6}, false},
+	{"rightarrow", [2]rune{0x2192}, false},
+	{"cemptyv", [2]rune{0x29B2}, false},
+	{"rcaron", [2]rune{0x0159}, false},
+	{"Idot", [2]rune{0x0130}, false},
+	{"DScy", [2]rune{0x0405}, false},
+	{"Verbar", [2]rune{0x2016}, false},
+	{"udblac", [2]rune{0x0171}, false},
+	{"Wfr", [2]rune{0x1D51A}, false},
+	{"notindot", [2]rune{0x22F5, 0x0338}, false},
+	{"frac56", [2]rune{0x215A}, false},
+	{"olcross", [2]rune{0x29BB}, false},
+	{"tilde", [2]rune{0x02DC}, false},
+	{"suplarr", [2]rune{0x297B}, false},
+	{"sime", [2]rune{0x2243}, false},
+	{"uuml", [2]rune{0x00FC}, true},
+	{"checkmark", [2]rune{0x2713}, false},
+	{"gl", [2]rune{0x2277}, false},
+	{"Ncaron", [2]rune{0x0147}, false},
+	{"hybull", [2]rune{0x2043}, false},
+	{"gjcy", [2]rune{0x0453}, false},
+	{"Zopf", [2]rune{0x2124}, false},
+	{"bsolhsub", [2]rune{0x27C8}, false},
+	{"boxDL", [2]rune{0x2557}, false},
+	{"IEcy", [2]rune{0x0415}, false},
+	{"larr", [2]rune{0x2190}, false},
+	{"boxdl", [2]rune{0x2510}, false},
+	{"notinva", [2]rune{0x2209}, false},
+	{"cirfnint", [2]rune{0x2A10}, false},
+	{"Diamond", [2]rune{0x22C4}, false},
+	{"ShortUpArrow", [2]rune{0x2191}, false},
+	{"Cedilla", [2]rune{0x00B8}, false},
+	{"lsqb", [2]rune{0x005B}, false},
+	{"RuleDelayed", [2]rune{0x29F4}, false},
+	{"UpTee", [2]rune{0x22A5}, false},
+	{"iexcl", [2]rune{0x00A1}, true},
+	{"slarr", [2]rune{0x2190}, false},
+	{"rsh", [2]rune{0x21B1}, false},
+	{"Dcy", [2]rune{0x0414}, false},
+	{"mp", [2]rune{0x2213}, false},
+	{"ExponentialE", [2]rune{0x2147}, false},
+	{"jfr", [2]rune{0x1D527}, false},
+	{"SupersetEqual", [2]rune{0x2287}, false},
+	{"sccue", [2]rune{0x227D}, false},
+	{"notinvb", [2]rune{0x22F7}, false},
+	{"thickapprox", [2]rune{0x2248}, false},
+	{"ncong", [2]rune{0x2247}, false},
+	{"ntriangleleft", [2]rune{0x22EA}, false},
+	{"nvHarr", [2]rune{0x2904}, false},
+	{"planckh", [2]rune{0x210E}, false},
+	{"HilbertSpace", [2]rune{0x210B}, false},
+	{"dd", [2]rune{0x2146}, false},
+	{"Yopf", [2]rune{0x1D550}, false},
+	{"DD", [2]rune{0x2145}, false},
+	{"nbumpe", [2]rune{0x224F, 0x0338}, false},
+	{"Laplacetrf", [2]rune{0x2112}, false},
+	{"LessSlantEqual", [2]rune{0x2A7D}, false},
+	{"shcy", [2]rune{0x0448}, false},
+	{"Ugrave", [2]rune{0x00D9}, true},
+	{"wedbar", [2]rune{0x2A5F}, false},
+	{"tcedil", [2]rune{0x0163}, false},
+	{"UpperRightArrow", [2]rune{0x2197}, false},
+	{"DZcy", [2]rune{0x040F}, false},
+	{"olarr", [2]rune{0x21BA}, false},
+	{"Uarrocir", [2]rune{0x2949}, false},
+	{"eqslantless", [2]rune{0x2A95}, false},
+	{"hslash", [2]rune{0x210F}, false},
+	{"frac25", [2]rune{0x2156}, false},
+	{"Upsilon", [2]rune{0x03A5}, false},
+	{"YAcy", [2]rune{0x042F}, false},
+	{"DownLeftVectorBar", [2]rune{0x2956}, false},
+	{"latail", [2]rune{0x2919}, false},
+	{"cudarrr", [2]rune{0x2935}, false},
+	{"frac23", [2]rune{0x2154}, false},
+	{"gsime", [2]rune{0x2A8E}, false},
+	{"rnmid", [2]rune{0x2AEE}, false},
+	{"Gcirc", [2]rune{0x011C}, false},
+	{"planck", [2]rune{0x210F}, false},
+	{"ZHcy", [2]rune{0x0416}, false},
+	{"OverBracket", [2]rune{0x23B4}, false},
+	{"Auml", [2]rune{0x00C4}, true},
+	{"Gdot", [2]rune{0x0120}, false},
+	{"looparrowleft", [2]rune{0x21AB}, false},
+	{"nlArr", [2]rune{0x21CD}, false},
+	{"boxbox", [2]rune{0x29C9}, false},
+	{"Igrave", [2]rune{0x00CC}, true},
+	{"LeftTriangle", [2]rune{0x22B2}, false},
+	{"approx", [2]rune{0x2248}, false},
+	{"Xopf", [2]rune{0x1D54F}, false},
+	{"ltimes", [2]rune{0x22C9}, false},
+	{"Lmidot", [2]rune{0x013F}, false},
+	{"lowbar", [2]rune{0x005F}, false},
+	{"jcy", [2]rune{0x0439}, false},
+	{"twoheadleftarrow", [2]rune{0x219E}, false},
+	{"HorizontalLine", [2]rune{0x2500}, false},
+	{"gtlPar", [2]rune{0x2995}, false},
+	{"CenterDot", [2]rune{0x00B7}, false},
+	{"NotSucceedsEqual", [2]rune{0x2AB0, 0x0338}, false},
+	{"image", [2]rune{0x2111}, false},
+	{"NotTildeTilde", [2]rune{0x2249}, false},
+	{"vartriangleright", [2]rune{0x22B3}, false},
+	{"larrb", [2]rune{0x21E4}, false},
+	{"rightsquigarrow", [2]rune{0x219D}, false},
+	{"Zcaron", [2]rune{0x017D}, false},
+	{"agrave", [2]rune{0x00E0}, true},
+	{"simg", [2]rune{0x2A9E}, false},
+	{"vnsub", [2]rune{0x2282, 0x20D2}, false},
+	{"orv", [2]rune{0x2A5B}, false},
+	{"bigodot", [2]rune{0x2A00}, false},
+	{"apacir", [2]rune{0x2A6F}, false},
+	{"gneqq", [2]rune{0x2269}, false},
+	{"ofcir", [2]rune{0x29BF}, false},
+	{"And", [2]rune{0x2A53}, false},
+	{"Tstrok", [2]rune{0x0166}, false},
+	{"malt", [2]rune{0x2720}, false},
+	{"lesseqgtr", [2]rune{0x22DA}, false},
+	{"spar", [2]rune{0x2225}, false},
+	{"phmmat", [2]rune{0x2133}, false},
+	{"becaus", [2]rune{0x2235}, false},
+	{"divideontimes", [2]rune{0x22C7}, false},
+	{"simplus", [2]rune{0x2A24}, false},
+	{"gne", [2]rune{0x2A88}, false},
+	{"times", [2]rune{0x00D7}, true},
+	{"smid", [2]rune{0x2223}, false},
+	{"Lang", [2]rune{0x27EA}, false},
+	{"ge", [2]rune{0x2265}, false},
+	{"KJcy", [2]rune{0x040C}, false},
+	{"gfr", [2]rune{0x1D524}, false},
+	{"bcong", [2]rune{0x224C}, false},
+	{"Wopf", [2]rune{0x1D54E}, false},
+	{"spadesuit", [2]rune{0x2660}, false},
+	{"ltri", [2]rune{0x25C3}, false},
+	{"supe", [2]rune{0x2287}, false},
+	{"copf", [2]rune{0x1D554}, false},
+	{"leqq", [2]rune{0x2266}, false},
+	{"tcy", [2]rune{0x0442}, false},
+	{"HumpEqual", [2]rune{0x224F}, false},
+	{"Zscr", [2]rune{0x1D4B5}, false},
+	{"race", [2]rune{0x223D, 0x0331}, false},
+	{"period", [2]rune{0x002E}, false},
+	{"Ncedil", [2]rune{0x0145}, false},
+	{"Hstrok", [2]rune{0x0126}, false},
+	{"zeetrf", [2]rune{0x2128}, false},
+	{"nisd", [2]rune{0x22FA}, false},
+	{"Pcy", [2]rune{0x041F}, false},
+	{"gneq", [2]rune{0x2A88}, false},
+	{"wp", [2]rune{0x2118}, false},
+	{"LeftTee", [2]rune{0x22A3}, false},
+	{"lstrok", [2]rune{0x0142}, false},
+	{"SHcy", [2]rune{0x0428}, false},
+	{"Yscr", [2]rune{0x1D4B4}, false},
+	{"sharp", [2]rune{0x266F}, false},
+	{"SquareUnion", [2]rune{0x2294}, false},
+	{"roplus", [2]rune{0x2A2E}, false},
+	{"squ", [2]rune{0x25A1}, false},
+	{"rtriltri", [2]rune{0x29CE}, false},
+	{"phi", [2]rune{0x03C6}, false},
+	{"RightTeeVector", [2]rune{0x295B}, false},
+	{"LJcy", [2]rune{0x0409}, false},
+	{"xhArr", [2]rune{0x27FA}, false},
+	{"UpTeeArrow", [2]rune{0x21A5}, false},
+	{"alefsym", [2]rune{0x2135}, false},
+	{"iocy", [2]rune{0x0451}, false},
+	{"boxUl", [2]rune{0x255C}, false},
+	{"Lsh", [2]rune{0x21B0}, false},
+	{"rightharpoondown", [2]rune{0x21C1}, false},
+	{"uharr", [2]rune{0x21BE}, false},
+	{"Uopf", [2]rune{0x1D54C}, false},
+	{"TildeEqual", [2]rune{0x2243}, false},
+	{"boxv", [2]rune{0x2502}, false},
+	{"napprox", [2]rune{0x2249}, false},
+	{"Vopf", [2]rune{0x1D54D}, false},
+	{"numsp", [2]rune{0x2007}, false},
+	{"boxdR", [2]rune{0x2552}, false},
+	{"commat", [2]rune{0x0040}, false},
+	{"Longleftrightarrow", [2]rune{0x27FA}, false},
+	{"lesseqqgtr", [2]rune{0x2A8B}, false},
+	{"cfr", [2]rune{0x1D520}, false},
+	{"xcirc", [2]rune{0x25EF}, false},
+	{"vzigzag", [2]rune{0x299A}, false},
+	{"khcy", [2]rune{0x0445}, false},
+	{"vfr", [2]rune{0x1D533}, false},
+	{"ecir", [2]rune{0x2256}, false},
+	{"ovbar", [2]rune{0x233D}, false},
+	{"NotElement", [2]rune{0x2209}, false},
+	{"gamma", [2]rune{0x03B3}, false},
+	{"curarrm", [2]rune{0x293C}, false},
+	{"cupbrcap", [2]rune{0x2A48}, false},
+	{"rcedil", [2]rune{0x0157}, false},
+	{"Assign", [2]rune{0x2254}, false},
+	{"UnderParenthesis", [2]rune{0x23DD}, false},
+	{"DoubleLeftArrow", [2]rune{0x21D0}, false},
+	{"Equilibrium", [2]rune{0x21CC}, false},
+	{"Atilde", [2]rune{0x00C3}, true},
+	{"nprec", [2]rune{0x2280}, false},
+	{"ThinSpace", [2]rune{0x2009}, false},
+	{"prsim", [2]rune{0x227E}, false},
+	{"blk14", [2]rune{0x2591}, false},
+	{"male", [2]rune{0x2642}, false},
+	{"cir", [2]rune{0x25CB}, false},
+	{"napos", [2]rune{0x0149}, false},
+	{"ntlg", [2]rune{0x2278}, false},
+	{"boxUr", [2]rune{0x2559}, false},
+	{"nprcue", [2]rune{0x22E0}, false},
+	{"DoubleUpDownArrow", [2]rune{0x21D5}, false},
+	{"uharl", [2]rune{0x21BF}, false},
+	{"npar", [2]rune{0x2226}, false},
+	{"rbrack", [2]rune{0x005D}, false},
+	{"hookrightarrow", [2]rune{0x21AA}, false},
+	{"vcy", [2]rune{0x0432}, false},
+	{"cularrp", [2]rune{0x293D}, false},
+	{"VDash", [2]rune{0x22AB}, false},
+	{"Scaron", [2]rune{0x0160}, false},
+	{"profalar", [2]rune{0x232E}, false},
+	{"NotLeftTriangle", [2]rune{0x22EA}, false},
+	{"RightArrow", [2]rune{0x2192}, false},
+	{"uHar", [2]rune{0x2963}, false},
+	{"pitchfork", [2]rune{0x22D4}, false},
+	{"geq", [2]rune{0x2265}, false},
+	{"RightTeeArrow", [2]rune{0x21A6}, false},
+	{"lceil", [2]rune{0x2308}, false},
+	{"thinsp", [2]rune{0x2009}, false},
+	{"nbsp", [2]rune{0x00A0}, true},
+	{"fork", [2]rune{0x22D4}, false},
+	{"SOFTcy", [2]rune{0x042C}, false},
+	{"blk12", [2]rune{0x2592}, false},
+	{"gE", [2]rune{0x2267}, false},
+	{"sigmaf", [2]rune{0x03C2}, false},
+	{"lhblk", [2]rune{0x2584}, false},
+	{"nge", [2]rune{0x2271}, false},
+	{"ultri", [2]rune{0x25F8}, false},
+	{"utrif", [2]rune{0x25B4}, false},
+	{"hellip", [2]rune{0x2026}, false},
+	{"rbbrk", [2]rune{0x2773}, false},
+	{"Ifr", [2]rune{0x2111}, false},
+	{"rbrace", [2]rune{0x007D}, false},
+	{"Cdot", [2]rune{0x010A}, false},
+	{"Ecirc", [2]rune{0x00CA}, true},
+	{"xvee", [2]rune{0x22C1}, false},
+	{"LeftRightVector", [2]rune{0x294E}, false},
+	{"or", [2]rune{0x2228}, false},
+	{"rsquo", [2]rune{0x2019}, false},
+	{"harr", [2]rune{0x2194}, false},
+	{"dotsquare", [2]rune{0x22A1}, false},
+	{"zdot", [2]rune{0x017C}, false},
+	{"supE", [2]rune{0x2AC6}, false},
+	{"Icy", [2]rune{0x0418}, false},
+	{"boxdL", [2]rune{0x2555}, false},
+	{"boxh", [2]rune{0x2500}, false},
+	{"omacr", [2]rune{0x014D}, false},
+	{"NotSubsetEqual", [2]rune{0x2288}, false},
+	{"frasl", [2]rune{0x2044}, false},
+	{"ges", [2]rune{0x2A7E}, false},
+	{"utdot", [2]rune{0x22F0}, false},
+	{"lsquo", [2]rune{0x2018}, false},
+	{"andslope", [2]rune{0x2A58}, false},
+	{"shy", [2]rune{0x00AD}, true},
+	{"gel", [2]rune{0x22DB}, false},
+	{"NotLess", [2]rune{0x226E}, false},
+	{"angrtvb", [2]rune{0x22BE}, false},
+	{"Xscr", [2]rune{0x1D4B3}, false},
+	{"macr", [2]rune{0x00AF}, true},
+	{"dollar", [2]rune{0x0024}, false},
+	{"natural", [2]rune{0x266E}, false},
+	{"curarr", [2]rune{0x21B7}, false},
+	{"Topf", [2]rune{0x1D54B}, false},
+	{"lmoust", [2]rune{0x23B0}, false},
+	{"omid", [2]rune{0x29B6}, false},
+	{"cirE", [2]rune{0x29C3}, false},
+	{"nmid", [2]rune{0x2224}, false},
+	{"ouml", [2]rune{0x00F6}, true},
+	{"hcirc", [2]rune{0x0125}, false},
+	{"laemptyv", [2]rune{0x29B4}, false},
+	{"Or", [2]rune{0x2A54}, false},
+	{"djcy", [2]rune{0x0452}, false},
+	{"diamondsuit", [2]rune{0x2666}, false},
+	{"precsim", [2]rune{0x227E}, false},
+	{"olt", [2]rune{0x29C0}, false},
+	{"rfisht", [2]rune{0x297D}, false},
+	{"barvee", [2]rune{0x22BD}, false},
+	{"fpartint", [2]rune{0x2A0D}, false},
+	{"larrfs", [2]rune{0x291D}, false},
+	{"elsdot", [2]rune{0x2A97}, false},
+	{"lpar", [2]rune{0x0028}, false},
+	{"Wscr", [2]rune{0x1D4B2}, false},
+	{"TripleDot", [2]rune{0x20DB}, false},
+	{"telrec", [2]rune{0x2315}, false},
+	{"larrhk", [2]rune{0x21A9}, false},
+	{"MinusPlus", [2]rune{0x2213}, false},
+	{"ofr", [2]rune{0x1D52C}, false},
+	{"gesdot", [2]rune{0x2A80}, false},
+	{"DownLeftRightVector", [2]rune{0x2950}, false},
+	{"lEg", [2]rune{0x2A8B}, false},
+	{"LeftRightArrow", [2]rune{0x2194}, false},
+	{"colon", [2]rune{0x003A}, false},
+	{"af", [2]rune{0x2061}, false},
+	{"thicksim", [2]rune{0x223C}, false},
+	{"ulcorner", [2]rune{0x231C}, false},
+	{"barwedge", [2]rune{0x2305}, false},
+	{"NotRightTriangle", [2]rune{0x22EB}, false},
+	{"ocy", [2]rune{0x043E}, false},
+	{"Sopf", [2]rune{0x1D54A}, false},
+	{"gnE", [2]rune{0x2269}, false},
+	{"Vert", [2]rune{0x2016}, false},
+	{"Aacute", [2]rune{0x00C1}, true},
+	{"duhar", [2]rune{0x296F}, false},
+	{"eacute", [2]rune{0x00E9}, true},
+	{"rpargt", [2]rune{0x2994}, false},
+	{"napid", [2]rune{0x224B, 0x0338}, false},
+	{"Ropf", [2]rune{0x211D}, false},
+	{"DownTee", [2]rune{0x22A4}, false},
+	{"Yacute", [2]rune{0x00DD}, true},
+	{"Scedil", [2]rune{0x015E}, false},
+	{"trie", [2]rune{0x225C}, false},
+	{"csupe", [2]rune{0x2AD2}, false},
+	{"solbar", [2]rune{0x233F}, false},
+	{"searr", [2]rune{0x2198}, false},
+	{"oror", [2]rune{0x2A56}, false},
+	{"imacr", [2]rune{0x012B}, false},
+	{"jcirc", [2]rune{0x0135}, false},
+	{"leftthreetimes", [2]rune{0x22CB}, false},
+	{"udarr", [2]rune{0x21C5}, false},
+	{"xwedge", [2]rune{0x22C0}, false},
+	{"softcy", [2]rune{0x044C}, false},
+	{"Lt", [2]rune{0x226A}, false},
+	{"Bfr", [2]rune{0x1D505}, false},
+	{"prop", [2]rune{0x221D}, false},
+	{"Tilde", [2]rune{0x223C}, false},
+	{"ac", [2]rune{0x223E}, false},
+	{"hearts", [2]rune{0x2665}, false},
+	{"Backslash", [2]rune{0x2216}, false},
+	{"shortparallel", [2]rune{0x2225}, false},
+	{"supseteq", [2]rune{0x2287}, false},
+	{"ord", [2]rune{0x2A5D}, false},
+	{"DownTeeArrow", [2]rune{0x21A7}, false},
+	{"Vvdash", [2]rune{0x22AA}, false},
+	{"Pfr", [2]rune{0x1D513}, false},
+	{"subset", [2]rune{0x2282}, false},
+	{"triplus", [2]rune{0x2A39}, false},
+	{"opar", [2]rune{0x29B7}, false},
+	{"urcorner", [2]rune{0x231D}, false},
+	{"rHar", [2]rune{0x2964}, false},
+	{"kcedil", [2]rune{0x0137}, false},
+	{"UpArrowBar", [2]rune{0x2912}, false},
+	{"profline", [2]rune{0x2312}, false},
+	{"rangle", [2]rune{0x27E9}, false},
+	{"Product", [2]rune{0x220F}, false},
+	{"geqq", [2]rune{0x2267}, false},
+	{"Vscr", [2]rune{0x1D4B1}, false},
+	{"langd", [2]rune{0x2991}, false},
+	{"amalg", [2]rune{0x2A3F}, false},
+	{"NegativeMediumSpace", [2]rune{0x200B}, false},
+	{"lAtail", [2]rune{0x291B}, false},
+	{"Bcy", [2]rune{0x0411}, false},
+	{"ascr", [2]rune{0x1D4B6}, false},
+	{"boxV", [2]rune{0x2551}, false},
+	{"gesdoto", [2]rune{0x2A82}, false},
+	{"NJcy", [2]rune{0x040A}, false},
+	{"tdot", [2]rune{0x20DB}, false},
+	{"Odblac", [2]rune{0x0150}, false},
+	{"hercon", [2]rune{0x22B9}, false},
+	{"Lcaron", [2]rune{0x013D}, false},
+	{"amacr", [2]rune{0x0101}, false},
+	{"neArr", [2]rune{0x21D7}, false},
+	{"lrhar", [2]rune{0x21CB}, false},
+	{"supedot", [2]rune{0x2AC4}, false},
+	{"DownArrowBar", [2]rune{0x2913}, false},
+	{"expectation", [2]rune{0x2130}, false},
+	{"Gcedil", [2]rune{0x0122}, false},
+	{"Ufr", [2]rune{0x1D518}, false},
+	{"LessFullEqual", [2]rune{0x2266}, false},
+	{"smashp", [2]rune{0x2A33}, false},
+	{"lagran", [2]rune{0x2112}, false},
+	{"succcurlyeq", [2]rune{0x227D}, false},
+	{"minusdu", [2]rune{0x2A2A}, false},
+	{"precnapprox", [2]rune{0x2AB9}, false},
+	{"lbbrk", [2]rune{0x2772}, false},
+	{"sdot", [2]rune{0x22C5}, false},
+	{"half", [2]rune{0x00BD}, false},
+	{"zwj", [2]rune{0x200D}, false},
+	{"lne", [2]rune{0x2A87}, false},
+	{"psi", [2]rune{0x03C8}, false},
+	{"prod", [2]rune{0x220F}, false},
+	{"wedge", [2]rune{0x2227}, false},
+	{"Qopf", [2]rune{0x211A}, false},
+	{"lessgtr", [2]rune{0x2276}, false},
+	{"eth", [2]rune{0x00F0}, true},
+	{"questeq", [2]rune{0x225F}, false},
+	{"infintie", [2]rune{0x29DD}, false},
+	{"radic", [2]rune{0x221A}, false},
+	{"dcaron", [2]rune{0x010F}, false},
+	{"iukcy", [2]rune{0x0456}, false},
+	{"sup2", [2]rune{0x00B2}, true},
+	{"cuvee", [2]rune{0x22CE}, false},
+	{"trade", [2]rune{0x2122}, false},
+	{"quest", [2]rune{0x003F}, false},
+	{"hfr", [2]rune{0x1D525}, false},
+	{"NestedLessLess", [2]rune{0x226A}, false},
+	{"Tscr", [2]rune{0x1D4AF}, false},
+	{"NotLessEqual", [2]rune{0x2270}, false},
+	{"pertenk", [2]rune{0x2031}, false},
+	{"Uscr", [2]rune{0x1D4B0}, false},
+	{"bumpe", [2]rune{0x224F}, false},
+	{"imof", [2]rune{0x22B7}, false},
+	{"intlarhk", [2]rune{0x2A17}, false},
+	{"lAarr", [2]rune{0x21DA}, false},
+	{"boxUR", [2]rune{0x255A}, false},
+	{"Sub", [2]rune{0x22D0}, false},
+	{"NotGreaterFullEqual", [2]rune{0x2267, 0x0338}, false},
+	{"sqsupseteq", [2]rune{0x2292}, false},
+	{"backsim", [2]rune{0x223D}, false},
+	{"DJcy", [2]rune{0x0402}, false},
+	{"larrlp", [2]rune{0x21AB}, false},
+	{"gsiml", [2]rune{0x2A90}, false},
+	{"lates", [2]rune{0x2AAD, 0xFE00}, false},
+	{"blacklozenge", [2]rune{0x29EB}, false},
+	{"marker", [2]rune{0x25AE}, false},
+	{"Tcedil", [2]rune{0x0162}, false},
+	{"CloseCurlyQuote", [2]rune{0x2019}, false},
+	{"Mellintrf", [2]rune{0x2133}, false},
+	{"nsubset", [2]rune{0x2282, 0x20D2}, false},
+	{"NotRightTriangleBar", [2]rune{0x29D0, 0x0338}, false},
+	{"luruhar", [2]rune{0x2966}, false},
+	{"gtdot", [2]rune{0x22D7}, false},
+	{"afr", [2]rune{0x1D51E}, false},
+	{"eta", [2]rune{0x03B7}, false},
+	{"ltcc", [2]rune{0x2AA6}, false},
+	{"topcir", [2]rune{0x2AF1}, false},
+	{"infin", [2]rune{0x221E}, false},
+	{"gEl", [2]rune{0x2A8C}, false},
+	{"ReverseUpEquilibrium", [2]rune{0x296F}, false},
+	{"utri", [2]rune{0x25B5}, false},
+	{"GreaterEqualLess", [2]rune{0x22DB}, false},
+	{"LeftArrowBar", [2]rune{0x21E4}, false},
+	{"gtquest", [2]rune{0x2A7C}, false},
+	{"gg", [2]rune{0x226B}, false},
+	{"Not", [2]rune{0x2AEC}, false},
+	{"Popf", [2]rune{0x2119}, false},
+	{"boxur", [2]rune{0x2514}, false},
+	{"downdownarrows", [2]rune{0x21CA}, false},
+	{"LeftAngleBracket", [2]rune{0x27E8}, false},
+	{"ddotseq", [2]rune{0x2A77}, false},
+	{"eng", [2]rune{0x014B}, false},
+	{"Mopf", [2]rune{0x1D544}, false},
+	{"ctdot", [2]rune{0x22EF}, false},
+	{"Exists", [2]rune{0x2203}, false},
+	{"frac14", [2]rune{0x00BC}, true},
+	{"bumpE", [2]rune{0x2AAE}, false},
+	{"Hacek", [2]rune{0x02C7}, false},
+	{"leftharpoonup", [2]rune{0x21BC}, false},
+	{"measuredangle", [2]rune{0x2221}, false},
+	{"GJcy", [2]rune{0x0403}, false},
+	{"lthree", [2]rune{0x22CB}, false},
+	{"bigwedge", [2]rune{0x22C0}, false},
+	{"sup1", [2]rune{0x00B9}, true},
+	{"nabla", [2]rune{0x2207}, false},
+	{"Congruent", [2]rune{0x2261}, false},
+	{"IOcy", [2]rune{0x0401}, false},
+	{"dtri", [2]rune{0x25BF}, false},
+	{"SquareSubsetEqual", [2]rune{0x2291}, false},
+	{"les", [2]rune{0x2A7D}, false},
+	{"esdot", [2]rune{0x2250}, false},
+	{"iprod", [2]rune{0x2A3C}, false},
+	{"rpar", [2]rune{0x0029}, false},
+	{"Nopf", [2]rune{0x2115}, false},
+	{"Nfr", [2]rune{0x1D511}, false},
+	{"uparrow", [2]rune{0x2191}, false},
+	{"lesdot", [2]rune{0x2A7F}, false},
+	{"boxUL", [2]rune{0x255D}, false},
+	{"LeftDownTeeVector", [2]rune{0x2961}, false},
+	{"otilde", [2]rune{0x00F5}, true},
+	{"Ucy", [2]rune{0x0423}, false},
+	{"Umacr", [2]rune{0x016A}, false},
+	{"toea", [2]rune{0x2928}, false},
+	{"boxH", [2]rune{0x2550}, false},
+	{"Sup", [2]rune{0x22D1}, false},
+	{"KHcy", [2]rune{0x0425}, false},
+	{"Proportion", [2]rune{0x2237}, false},
+	{"Yuml", [2]rune{0x0178}, false},
+	{"Sscr", [2]rune{0x1D4AE}, false},
+	{"rx", [2]rune{0x211E}, false},
+	{"boxtimes", [2]rune{0x22A0}, false},
+	{"leftrightarrow", [2]rune{0x2194}, false},
+	{"succneqq", [2]rune{0x2AB6}, false},
+	{"ulcorn", [2]rune{0x231C}, false},
+	{"xi", [2]rune{0x03BE}, false},
+	{"Rscr", [2]rune{0x211B}, false},
+	{"nlsim", [2]rune{0x2274}, false},
+	{"omicron", [2]rune{0x03BF}, false},
+	{"Gamma", [2]rune{0x0393}, false},
+	{"DoubleLeftRightArrow", [2]rune{0x21D4}, false},
+	{"Lopf", [2]rune{0x1D543}, false},
+	{"acy", [2]rune{0x0430}, false},
+	{"sqcups", [2]rune{0x2294, 0xFE00}, false},
+	{"boxul", [2]rune{0x2518}, false},
+	{"GreaterSlantEqual", [2]rune{0x2A7E}, false},
+	{"Oopf", [2]rune{0x1D546}, false},
+	{"Bumpeq", [2]rune{0x224E}, false},
+	{"backcong", [2]rune{0x224C}, false},
+	{"bNot", [2]rune{0x2AED}, false},
+	{"Im", [2]rune{0x2111}, false},
+	{"DoubleDownArrow", [2]rune{0x21D3}, false},
+	{"top", [2]rune{0x22A4}, false},
+	{"Sum", [2]rune{0x2211}, false},
+	{"Racute", [2]rune{0x0154}, false},
+	{"forkv", [2]rune{0x2AD9}, false},
+	{"Rho", [2]rune{0x03A1}, false},
+	{"simdot", [2]rune{0x2A6A}, false},
+	{"angst", [2]rune{0x00C5}, false},
+	{"llcorner", [2]rune{0x231E}, false},
+	{"emacr", [2]rune{0x0113}, false},
+	{"complement", [2]rune{0x2201}, false},
+	{"dfisht", [2]rune{0x297F}, false},
+	{"NotSucceeds", [2]rune{0x2281}, false},
+	{"DoubleDot", [2]rune{0x00A8}, false},
+	{"nsupseteq", [2]rune{0x2289}, false},
+	{"rlhar", [2]rune{0x21CC}, false},
+	{"downarrow", [2]rune{0x2193}, false},
+	{"ruluhar", [2]rune{0x2968}, false},
+	{"tfr", [2]rune{0x1D531}, false},
+	{"dblac", [2]rune{0x02DD}, false},
+	{"beth", [2]rune{0x2136}, false},
+	{"leg", [2]rune{0x22DA}, false},
+	{"Lcedil", [2]rune{0x013B}, false},
+	{"chcy", [2]rune{0x0447}, false},
+	{"iuml", [2]rune{0x00EF}, true},
+	{"Kopf", [2]rune{0x1D542}, false},
+	{"abreve", [2]rune{0x0103}, false},
+	{"Ncy", [2]rune{0x041D}, false},
+	{"Colone", [2]rune{0x2A74}, false},
+	{"nesear", [2]rune{0x2928}, false},
+	{"FilledSmallSquare", [2]rune{0x25FC}, false},
+	{"Ecaron", [2]rune{0x011A}, false},
+	{"NotDoubleVerticalBar", [2]rune{0x2226}, false},
+	{"Edot", [2]rune{0x0116}, false},
+	{"elinters", [2]rune{0x23E7}, false},
+	{"cularr", [2]rune{0x21B6}, false},
+	{"supsetneq", [2]rune{0x228B}, false},
+	{"ApplyFunction", [2]rune{0x2061}, false},
+	{"Barwed", [2]rune{0x2306}, false},
+	{"nvdash", [2]rune{0x22AC}, false},
+	{"NotLeftTriangleBar", [2]rune{0x29CF, 0x0338}, false},
+	{"cent", [2]rune{0x00A2}, true},
+	{"cupor", [2]rune{0x2A45}, false},
+	{"mcomma", [2]rune{0x2A29}, false},
+	{"Cayleys", [2]rune{0x212D}, false},
+	{"boxDr", [2]rune{0x2553}, false},
+	{"LeftVectorBar", [2]rune{0x2952}, false},
+	{"CircleDot", [2]rune{0x2299}, false},
+	{"blacktriangle", [2]rune{0x25B4}, false},
+	{"LT", [2]rune{0x003C}, true},
+	{"cwint", [2]rune{0x2231}, false},
+	{"lrcorner", [2]rune{0x231F}, false},
+	{"scpolint", [2]rune{0x2A13}, false},
+	{"boxplus", [2]rune{0x229E}, false},
+	{"leqslant", [2]rune{0x2A7D}, false},
+	{"Qscr", [2]rune{0x1D4AC}, false},
+	{"lnap", [2]rune{0x2A89}, false},
+	{"par", [2]rune{0x2225}, false},
+	{"Omacr", [2]rune{0x014C}, false},
+	{"target", [2]rune{0x2316}, false},
+	{"simlE", [2]rune{0x2A9F}, false},
+	{"DoubleLongLeftRightArrow", [2]rune{0x27FA}, false},
+	{"nwnear", [2]rune{0x2927}, false},
+	{"lessapprox", [2]rune{0x2A85}, false},
+	{"minusd", [2]rune{0x2238}, false},
+	{"equest", [2]rune{0x225F}, false},
+	{"Sqrt", [2]rune{0x221A}, false},
+	{"prcue", [2]rune{0x227C}, false},
+	{"plussim", [2]rune{0x2A26}, false},
+	{"bernou", [2]rune{0x212C}, false},
+	{"Ubreve", [2]rune{0x016C}, false},
+	{"sup3", [2]rune{0x00B3}, true},
+	{"Gfr", [2]rune{0x1D50A}, false},
+	{"siml", [2]rune{0x2A9D}, false},
+	{"Hcirc", [2]rune{0x0124}, false},
+	{"REG", [2]rune{0x00AE}, true},
+	{"dotminus", [2]rune{0x2238}, false},
+	{"lnE", [2]rune{0x2268}, false},
+	{"epsi", [2]rune{0x03B5}, false},
+	{"vnsup", [2]rune{0x2283, 0x20D2}, false},
+	{"cuepr", [2]rune{0x22DE}, false},
+	{"mfr", [2]rune{0x1D52A}, false},
+	{"odiv", [2]rune{0x2A38}, false},
+	{"Gcy", [2]rune{0x0413}, false},
+	{"varkappa", [2]rune{0x03F0}, false},
+	{"Intersection", [2]rune{0x22C2}, false},
+	{"drcorn", [2]rune{0x231F}, false},
+	{"bkarow", [2]rune{0x290D}, false},
+	{"beta", [2]rune{0x03B2}, false},
+	{"tridot", [2]rune{0x25EC}, false},
+	{"blacktriangledown", [2]rune{0x25BE}, false},
+	{"nearrow", [2]rune{0x2197}, false},
+	{"ClockwiseContourIntegral", [2]rune{0x2232}, false},
+	{"CloseCurlyDoubleQuote", [2]rune{0x201D}, false},
+	{"Xi", [2]rune{0x039E}, false},
+	{"LeftTriangleBar", [2]rune{0x29CF}, false},
+	{"bfr", [2]rune{0x1D51F}, false},
+	{"LeftFloor", [2]rune{0x230A}, false},
+	{"asympeq", [2]rune{0x224D}, false},
+	{"midast", [2]rune{0x002A}, false},
+	{"lHar", [2]rune{0x2962}, false},
+	{"DownArrowUpArrow", [2]rune{0x21F5}, false},
+	{"Jsercy", [2]rune{0x0408}, false},
+	{"RightDownTeeVector", [2]rune{0x295D}, false},
+	{"nscr", [2]rune{0x1D4C3}, false},
+	{"Longleftarrow", [2]rune{0x27F8}, false},
+	{"realpart", [2]rune{0x211C}, false},
+	{"epar", [2]rune{0x22D5}, false},
+	{"smte", [2]rune{0x2AAC}, false},
+	{"Emacr", [2]rune{0x0112}, false},
+	{"Cap", [2]rune{0x22D2}, false},
+	{"npr", [2]rune{0x2280}, false},
+	{"boxuR", [2]rune{0x2558}, false},
+	{"lobrk", [2]rune{0x27E6}, false},
+	{"doublebarwedge", [2]rune{0x2306}, false},
+	{"lt", [2]rune{0x003C}, true},
+	{"angzarr", [2]rune{0x237C}, false},
+	{"Jcirc", [2]rune{0x0134}, false},
+	{"zopf", [2]rune{0x1D56B}, false},
+	{"Leftrightarrow", [2]rune{0x21D4}, false},
+	{"mid", [2]rune{0x2223}, false},
+	{"lg", [2]rune{0x2276}, false},
+	{"gvertneqq", [2]rune{0x2269, 0xFE00}, false},
+	{"NotSquareSuperset", [2]rune{0x2290, 0x0338}, false},
+	{"shortmid", [2]rune{0x2223}, false},
+	{"cuesc", [2]rune{0x22DF}, false},
+	{"mcy", [2]rune{0x043C}, false},
+	{"triminus", [2]rune{0x2A3A}, false},
+	{"rbrke", [2]rune{0x298C}, false},
+	{"LeftUpVector", [2]rune{0x21BF}, false},
+	{"varsupsetneqq", [2]rune{0x2ACC, 0xFE00}, false},
+	{"lvertneqq", [2]rune{0x2268, 0xFE00}, false},
+	{"it", [2]rune{0x2062}, false},
+	{"swArr", [2]rune{0x21D9}, false},
+	{"ohbar", [2]rune{0x29B5}, false},
+	{"Udblac", [2]rune{0x0170}, false},
+	{"cacute", [2]rune{0x0107}, false},
+	{"nGg", [2]rune{0x22D9, 0x0338}, false},
+	{"ll", [2]rune{0x226A}, false},
+	{"gtrarr", [2]rune{0x2978}, false},
+	{"uml", [2]rune{0x00A8}, true},
+	{"bigvee", [2]rune{0x22C1}, false},
+	{"otimes", [2]rune{0x2297}, false},
+	{"nwarr", [2]rune{0x2196}, false},
+	{"dlcorn", [2]rune{0x231E}, false},
+	{"NotHumpEqual", [2]rune{0x224F, 0x0338}, false},
+	{"darr", [2]rune{0x2193}, false},
+	{"Updownarrow", [2]rune{0x21D5}, false},
+	{"nGt", [2]rune{0x226B, 0x20D2}, false},
+	{"fllig", [2]rune{0xFB02}, false},
+	{"hairsp", [2]rune{0x200A}, false},
+	{"yucy", [2]rune{0x044E}, false},
+	{"egsdot", [2]rune{0x2A98}, false},
+	{"Pscr", [2]rune{0x1D4AB}, false},
+	{"Mscr", [2]rune{0x2133}, false},
+	{"angrtvbd", [2]rune{0x299D}, false},
+	{"Therefore", [2]rune{0x2234}, false},
+	{"disin", [2]rune{0x22F2}, false},
+	{"kappa", [2]rune{0x03BA}, false},
+	{"pound", [2]rune{0x00A3}, true},
+	{"ngsim", [2]rune{0x2275}, false},
+	{"vBarv", [2]rune{0x2AE9}, false},
+	{"bprime", [2]rune{0x2035}, false},
+	{"rArr", [2]rune{0x21D2}, false},
+	{"lambda", [2]rune{0x03BB}, false},
+	{"bnequiv", [2]rune{0x2261, 0x20E5}, false},
+	{"nsube", [2]rune{0x2288}, false},
+	{"parsim", [2]rune{0x2AF3}, false},
+	{"nequiv", [2]rune{0x2262}, false},
+	{"boxhu", [2]rune{0x2534}, false},
+	{"UpDownArrow", [2]rune{0x2195}, false},
+	{"Equal", [2]rune{0x2A75}, false},
+	{"acE", [2]rune{0x223E, 0x0333}, false},
+	{"realine", [2]rune{0x211B}, false},
+	{"boxuL", [2]rune{0x255B}, false},
+	{"Poincareplane", [2]rune{0x210C}, false},
+	{"frac78", [2]rune{0x215E}, false},
+	{"RightUpVector", [2]rune{0x21BE}, false},
+	{"rdca", [2]rune{0x2937}, false},
+	{"ensp", [2]rune{0x2002}, false},
+	{"Nscr", [2]rune{0x1D4A9}, false},
+	{"Gscr", [2]rune{0x1D4A2}, false},
+	{"NegativeThinSpace", [2]rune{0x200B}, false},
+	{"odot", [2]rune{0x2299}, false},
+	{"NotTildeFullEqual", [2]rune{0x2247}, false},
+	{"Star", [2]rune{0x22C6}, false},
+	{"hbar", [2]rune{0x210F}, false},
+	{"Wedge", [2]rune{0x22C0}, false},
+	{"oacute", [2]rune{0x00F3}, true},
+	{"tbrk", [2]rune{0x23B4}, false},
+	{"rang", [2]rune{0x27E9}, false},
+	{"lnsim", [2]rune{0x22E6}, false},
+	{"lbrke", [2]rune{0x298B}, false},
+	{"yfr", [2]rune{0x1D536}, false},
+	{"nGtv", [2]rune{0x226B, 0x0338}, false},
+	{"Sfr", [2]rune{0x1D516}, false},
+	{"DiacriticalGrave", [2]rune{0x0060}, false},
+	{"Jopf", [2]rune{0x1D541}, false},
+	{"OpenCurlyDoubleQuote", [2]rune{0x201C}, false},
+	{"Amacr", [2]rune{0x0100}, false},
+	{"fcy", [2]rune{0x0444}, false},
+	{"Element", [2]rune{0x2208}, false},
+	{"nsc", [2]rune{0x2281}, false},
+	{"umacr", [2]rune{0x016B}, false},
+	{"circledast", [2]rune{0x229B}, false},
+	{"Iukcy", [2]rune{0x0406}, false},
+	{"diams", [2]rune{0x2666}, false},
+	{"lozf", [2]rune{0x29EB}, false},
+	{"boxVh", [2]rune{0x256B}, false},
+	{"vArr", [2]rune{0x21D5}, false},
+	{"LeftVector", [2]rune{0x21BC}, false},
+	{"DoubleContourIntegral", [2]rune{0x222F}, false},
+	{"models", [2]rune{0x22A7}, false},
+	{"Superset", [2]rune{0x2283}, false},
+	{"plankv", [2]rune{0x210F}, false},
+	{"capcup", [2]rune{0x2A47}, false},
+	{"demptyv", [2]rune{0x29B1}, false},
+	{"vdash", [2]rune{0x22A2}, false},
+	{"NotGreaterGreater", [2]rune{0x226B, 0x0338}, false},
+	{"euml", [2]rune{0x00EB}, true},
+	{"NotPrecedesSlantEqual", [2]rune{0x22E0}, false},
+	{"nesim", [2]rune{0x2242, 0x0338}, false},
+	{"sigma", [2]rune{0x03C3}, false},
+	{"OverBrace", [2]rune{0x23DE}, false},
+	{"AMP", [2]rune{0x0026}, true},
+	{"mapstoleft", [2]rune{0x21A4}, false},
+	{"fjlig", [2]rune{0x0066, 0x006A}, false},
+	{"verbar", [2]rune{0x007C}, false},
+	{"in", [2]rune{0x2208}, false},
+	{"Zcy", [2]rune{0x0417}, false},
+	{"CircleMinus", [2]rune{0x2296}, false},
+	{"daleth", [2]rune{0x2138}, false},
+	{"NotSucceedsTilde", [2]rune{0x227F, 0x0338}, false},
+	{"oS", [2]rune{0x24C8}, false},
+	{"lsquor", [2]rune{0x201A}, false},
+	{"Lscr", [2]rune{0x2112}, false},
+	{"nleqslant", [2]rune{0x2A7D, 0x0338}, false},
+	{"DoubleRightTee", [2]rune{0x22A8}, false},
+	{"igrave", [2]rune{0x00EC}, true},
+	{"frac12", [2]rune{0x00BD}, true},
+	{"spades", [2]rune{0x2660}, false},
+	{"xutri", [2]rune{0x25B3}, false},
+	{"iota", [2]rune{0x03B9}, false},
+	{"angsph", [2]rune{0x2222}, false},
+	{"frac45", [2]rune{0x2158}, false},
+	{"ii", [2]rune{0x2148}, false},
+	{"cirmid", [2]rune{0x2AEF}, false},
+	{"zcaron", [2]rune{0x017E}, false},
+	{"circlearrowleft", [2]rune{0x21BA}, false},
+	{"mapsto", [2]rune{0x21A6}, false},
+	{"ncaron", [2]rune{0x0148}, false},
+	{"ic", [2]rune{0x2063}, false},
+	{"Rightarrow", [2]rune{0x21D2}, false},
+	{"lvnE", [2]rune{0x2268, 0xFE00}, false},
+	{"xrarr", [2]rune{0x27F6}, false},
+	{"ycy", [2]rune{0x044B}, false},
+	{"Vfr", [2]rune{0x1D519}, false},
+	{"nhArr", [2]rune{0x21CE}, false},
+}
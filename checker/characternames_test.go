@@ -1,6 +1,7 @@
 package checker
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -50,6 +51,7 @@ func ExampleIsCharacterReferenceName() {
 // BenchmarkIsCharacterReferenceNameTrue	 5000000	     351.0 ns/op	     0 B/op	       0 allocs/op # search sorted array
 // BenchmarkIsCharacterReferenceNameTrue	50000000	      50.4 ns/op	     0 B/op	       0 allocs/op # map[string]bool
 // BenchmarkIsCharacterReferenceNameTrue    50000000          22.9 ns/op         0 B/op        0 allocs/op # Go 1.2
+// BenchmarkIsCharacterReferenceNameTrue   150000000           9.0 ns/op         0 B/op        0 allocs/op # perfect hash, go1.27.1
 func BenchmarkIsCharacterReferenceNameTrue(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
@@ -60,6 +62,7 @@ func BenchmarkIsCharacterReferenceNameTrue(b *testing.B) {
 // BenchmarkIsCharacterReferenceNameFalse	 5000000	      347.0 ns/op	       0 B/op	       0 allocs/op # search sorted array
 // BenchmarkIsCharacterReferenceNameFalse	20000000	       79.6 ns/op	       0 B/op	       0 allocs/op # map[string]bool
 // BenchmarkIsCharacterReferenceNameFalse  50000000            38.2 ns/op          0 B/op          0 allocs/op # Go 1.2
+// BenchmarkIsCharacterReferenceNameFalse  150000000           8.6 ns/op         0 B/op        0 allocs/op # perfect hash, go1.27.1
 func BenchmarkIsCharacterReferenceNameFalse(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
@@ -67,6 +70,113 @@ func BenchmarkIsCharacterReferenceNameFalse(b *testing.B) {
 	}
 }
 
+// referenceMap is the map[string]bool that IsCharacterReferenceName used
+// before the perfect hash, kept for comparison in the benchmarks below. For a
+// whole name, the two take about the same time. The table is there for the
+// longest-match lookups, like MatchCharacterReference, which hash the string
+// once for every prefix, where the map hashes each prefix again. See
+// BenchmarkCharacterReferenceMapMatch.
+//
+var referenceMap = func() map[string]bool {
+	m := map[string]bool{}
+	for _, ref := range characterReferenceTable {
+		m[ref.name] = true
+	}
+	return m
+}()
+
+// BenchmarkCharacterReferenceMapTrue    150000000        10.2 ns/op         0 B/op        0 allocs/op # go1.27.1
+func BenchmarkCharacterReferenceMapTrue(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = referenceMap["nbsp"]
+	}
+}
+
+// BenchmarkCharacterReferenceMapFalse    150000000         7.6 ns/op         0 B/op        0 allocs/op # go1.27.1
+func BenchmarkCharacterReferenceMapFalse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = referenceMap["tuesday"]
+	}
+}
+
+// referenceMapMatch finds the longest name at the start of s with
+// referenceMap, the way MatchCharacterReference would have to without the
+// perfect hash: one map lookup, which hashes the prefix again, for each length.
+//
+func referenceMapMatch(s string) string {
+	n := 0
+	for n < len(s) && n < longestCharacterReferenceName && isASCIIAlphanumeric(s[n]) {
+		n++
+	}
+	for l := n; l >= shortestCharacterReferenceName; l-- {
+		if referenceMap[s[:l]] {
+			return s[:l]
+		}
+	}
+	return ""
+}
+
+// BenchmarkCharacterReferenceMapMatch   35000000          32.9 ns/op         0 B/op        0 allocs/op # go1.27.1
+func BenchmarkCharacterReferenceMapMatch(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		referenceMapMatch("notit; the set")
+	}
+}
+
+func TestMatchCharacterReference(t *testing.T) {
+
+	var cases = []struct {
+		Input     string
+		Name      string
+		Semicolon bool
+	}{
+		{"amp;", "amp", true},
+		{"amp", "amp", false},
+		{"amp x", "amp", false},
+		{"notin;", "notin", true},
+		{"notin", "not", false},
+		{"notit;", "not", false},
+		{"copy2014", "copy", false},
+		{"hearts;", "hearts", true},
+		{"hearts", "", false},
+		{"heartsx;", "", false},
+		{"CounterClockwiseContourIntegral;", "CounterClockwiseContourIntegral", true},
+		{"CounterClockwiseContourIntegrals;", "", false},
+		{"lt;", "lt", true},
+		{"l;", "", false},
+		{";", "", false},
+		{"", "", false},
+		{"\u2318;", "", false},
+	}
+
+	for _, c := range cases {
+		name, semicolon := MatchCharacterReference(c.Input)
+		if name != c.Name || semicolon != c.Semicolon {
+			t.Errorf("Expecting MatchCharacterReference(%q) to be %q, %v, but got %q, %v.",
+				c.Input, c.Name, c.Semicolon, name, semicolon)
+		}
+	}
+}
+
+func ExampleMatchCharacterReference() {
+	fmt.Println(MatchCharacterReference("notin; the set"))
+	fmt.Println(MatchCharacterReference("notit;"))
+	// Output:
+	// notin true
+	// not false
+}
+
+// BenchmarkMatchCharacterReference  60000000          20.7 ns/op         0 B/op        0 allocs/op # go1.27.1
+func BenchmarkMatchCharacterReference(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		MatchCharacterReference("notit; the set")
+	}
+}
+
 func TestIsCharacterReference(t *testing.T) {
 
 	names := []string{
@@ -105,6 +215,182 @@ func ExampleIsCharacterReference() {
 	// false
 }
 
+func TestCharacterReferenceRunes(t *testing.T) {
+
+	var cases = []struct {
+		Name   string
+		First  rune
+		Second rune
+		Ok     bool
+	}{
+		{"amp", '&', 0, true},
+		{"AMP", '&', 0, true},
+		{"hearts", '\u2665', 0, true},
+		{"nbsp", '\u00A0', 0, true},
+		{"Afr", '\U0001D504', 0, true},
+		{"nLt", '\u226A', '\u20D2', true},
+		{"NotEqualTilde", '\u2242', '\u0338', true},
+		{"Amp", 0, 0, false},
+		{"&amp;", 0, 0, false},
+		{"", 0, 0, false},
+	}
+
+	for _, c := range cases {
+		first, second, ok := CharacterReferenceRunes(c.Name)
+		if first != c.First || second != c.Second || ok != c.Ok {
+			t.Errorf("Expecting CharacterReferenceRunes(%q) to be %U, %U, %v, but got %U, %U, %v.",
+				c.Name, c.First, c.Second, c.Ok, first, second, ok)
+		}
+	}
+}
+
+func ExampleCharacterReferenceRunes() {
+	first, second, ok := CharacterReferenceRunes("hearts")
+	fmt.Printf("%c %d %v\n", first, second, ok)
+	first, second, ok = CharacterReferenceRunes("NotEqualTilde")
+	fmt.Printf("%U %U %v\n", first, second, ok)
+	// Output:
+	// ♥ 0 true
+	// U+2242 U+0338 true
+}
+
+func TestNamedReferenceFor(t *testing.T) {
+	cases := map[rune]string{
+		'&':          "amp",
+		'<':          "lt",
+		'"':          "quot",
+		'\u00A0':     "nbsp",
+		'\u00A9':     "copy",
+		'\u2192':     "rarr",
+		'\u2665':     "hearts",
+		'\U0001D504': "Afr",
+		'a':          "",
+		'\u2318':     "",
+	}
+	for r, expected := range cases {
+		name, ok := NamedReferenceFor(r)
+		if name != expected || ok != (expected != "") {
+			t.Errorf("Expecting NamedReferenceFor(%U) to be %q, but got %q, %v.",
+				r, expected, name, ok)
+		}
+	}
+
+	// Every name found must decode back to the same code point.
+	for _, ref := range characterReferenceTable {
+		runes := ref.runes
+		var name string
+		var ok bool
+		if runes[1] == 0 {
+			name, ok = NamedReferenceFor(runes[0])
+		} else {
+			name, ok = NamedReferenceForPair(runes[0], runes[1])
+		}
+		if !ok || lookupCharacterReference(name).runes != runes {
+			t.Errorf("Expecting the name for %U to decode to the same code points, but got %q.",
+				runes, name)
+		}
+	}
+}
+
+func TestNamedReferenceForPair(t *testing.T) {
+	if name, ok := NamedReferenceForPair('\u226A', '\u20D2'); name != "nLt" || !ok {
+		t.Errorf("Expecting NamedReferenceForPair(U+226A, U+20D2) to be \"nLt\", but got %q, %v.", name, ok)
+	}
+	if name, ok := NamedReferenceForPair('&', 0); name != "" || ok {
+		t.Errorf("Expecting NamedReferenceForPair(U+0026, 0) to be \"\", but got %q, %v.", name, ok)
+	}
+	if name, ok := NamedReferenceForPair('a', 'b'); name != "" || ok {
+		t.Errorf("Expecting NamedReferenceForPair(U+0061, U+0062) to be \"\", but got %q, %v.", name, ok)
+	}
+}
+
+func ExampleNamedReferenceFor() {
+	name, _ := NamedReferenceFor('\u2192')
+	fmt.Printf("&%s;\n", name)
+	_, ok := NamedReferenceFor('a')
+	fmt.Println(ok)
+	// Output:
+	// &rarr;
+	// false
+}
+
+// BenchmarkNamedReferenceFor  50000000         28.9 ns/op         0 B/op        0 allocs/op
+func BenchmarkNamedReferenceFor(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NamedReferenceFor('\u00A0')
+	}
+}
+
+func TestIsLegacyCharacterReferenceName(t *testing.T) {
+
+	names := []string{
+		"amp",
+		"AMP",
+		"lt",
+		"nbsp",
+		"copy",
+		"middot",
+	}
+	casesShouldBeTrue(t, names, IsLegacyCharacterReferenceName,
+		"Expected %#v to be a legacy character name, but got false")
+
+	notNames := []string{
+		"hearts", // valid, but needs a semicolon
+		"Amp",
+		"",
+		"copy;",
+	}
+	casesShouldBeFalse(t, notNames, IsLegacyCharacterReferenceName,
+		"Expected %#v to NOT be a legacy character name, but got true")
+
+	// Every legacy name must also be a character reference name.
+	for _, ref := range characterReferenceTable {
+		name := ref.name
+		if !ref.legacy {
+			continue
+		}
+		if !IsCharacterReferenceName(name) {
+			t.Errorf("Expected legacy name %#v to be a character reference name, but got false", name)
+		}
+		if len(name) > longestLegacyCharacterReferenceName {
+			t.Errorf("Expected legacy name %#v to be at most %d characters long",
+				name, longestLegacyCharacterReferenceName)
+		}
+	}
+}
+
+func TestLegacyCharacterReferencePrefix(t *testing.T) {
+	cases := map[string]string{
+		"":         "",
+		"a":        "",
+		"amp":      "amp",
+		"amp;":     "amp",
+		"notit;":   "not",
+		"notin;":   "not",
+		"copy2014": "copy",
+		"ltx":      "lt",
+		"hearts":   "",
+		"middots":  "middot",
+	}
+	for input, expected := range cases {
+		if actual := LegacyCharacterReferencePrefix(input); actual != expected {
+			t.Errorf("Expecting LegacyCharacterReferencePrefix(%q) to be %q, but got %q.",
+				input, expected, actual)
+		}
+	}
+}
+
+func ExampleLegacyCharacterReferencePrefix() {
+	fmt.Println(LegacyCharacterReferencePrefix("copy 2014"))
+	fmt.Println(LegacyCharacterReferencePrefix("notit;"))
+	fmt.Printf("%q\n", LegacyCharacterReferencePrefix("hearts"))
+	// Output:
+	// copy
+	// not
+	// ""
+}
+
 func casesShouldBeTrue(t *testing.T, cases []string, test func(string) bool, pattern string) {
 	for _, arg := range cases {
 		if test(arg) != true {
@@ -121,7 +407,65 @@ func casesShouldBeFalse(t *testing.T, cases []string, test func(string) bool, pa
 	}
 }
 
-// TestDownloadEntitiesJson checks the characterReferenceNames map against the
+// TestCharacterReferencesMatchEntitiesJSON checks the generated tables in
+// characternames_table.go against the vendored copy of entities.json, so that
+// the two can't drift apart. If this fails, run go generate.
+//
+func TestCharacterReferencesMatchEntitiesJSON(t *testing.T) {
+
+	data, err := ioutil.ReadFile("entities.json")
+	if err != nil {
+		t.Fatalf("Unable to read entities.json: %s", err)
+	}
+
+	if sum := fmt.Sprintf("%x", sha256.Sum256(data)); sum != entitiesJSONSHA256 {
+		t.Fatalf("entities.json has SHA-256 %s, but characternames_table.go was generated from %s. Run go generate.",
+			sum, entitiesJSONSHA256)
+	}
+
+	var entities map[string]struct {
+		Codepoints []rune `json:"codepoints"`
+	}
+	if err := json.Unmarshal(data, &entities); err != nil {
+		t.Fatalf("Unable to unmarshal entities.json: %s", err)
+	}
+
+	var names, legacy int
+	for key, e := range entities {
+		var expected [2]rune
+		copy(expected[:], e.Codepoints)
+
+		if strings.HasSuffix(key, ";") {
+			names++
+			name := strings.Trim(key, "&;")
+			first, second, ok := CharacterReferenceRunes(name)
+			if actual := [2]rune{first, second}; !ok || actual != expected {
+				t.Errorf("Expected %s to map to %U, but got %U, %v.", key, expected, actual, ok)
+			}
+		} else {
+			legacy++
+			if !IsLegacyCharacterReferenceName(key[1:]) {
+				t.Errorf("Expected %s to be a legacy character reference name, but got false.", key)
+			}
+		}
+	}
+
+	var tableLegacy int
+	for _, ref := range characterReferenceTable {
+		if ref.legacy {
+			tableLegacy++
+		}
+	}
+
+	if names != len(characterReferenceTable) {
+		t.Errorf("Expected %d character reference names, but got %d.", names, len(characterReferenceTable))
+	}
+	if legacy != tableLegacy {
+		t.Errorf("Expected %d legacy character reference names, but got %d.", legacy, tableLegacy)
+	}
+}
+
+// TestDownloadEntitiesJson checks the characterReferenceTable against the
 // list of entities from the WHATWG to make sure they are in sync.
 //
 // This test should be skipped by default and only enabled and run deliberately.
@@ -134,7 +478,12 @@ func xxTestDownloadEntitiesJson(t *testing.T) {
 		t.Skip("Skipping TestGetData in short mode.")
 	}
 
-	t.Log("Checking characterReferenceNames against the WHATWG list.")
+	t.Log("Checking characterReferenceTable against the WHATWG list.")
+
+	remaining := map[string]bool{}
+	for _, ref := range characterReferenceTable {
+		remaining[ref.name] = true
+	}
 
 	entitiesUrl := `http://www.whatwg.org/specs/web-apps/current-work/multipage/entities.json`
 	t.Log("URL", entitiesUrl)
@@ -181,9 +530,9 @@ func xxTestDownloadEntitiesJson(t *testing.T) {
 	// Check each entity against our list. Make sure it exists in the list.
 
 	for i, wanted := range expectedNames {
-		if characterReferenceNames[wanted] {
+		if remaining[wanted] {
 			// Name exists. Delete it so we can see if there are any left-overs.
-			delete(characterReferenceNames, wanted)
+			delete(remaining, wanted)
 		} else {
 			// There are some duplicate entries in entities.json.
 			if i > 0 && expectedNames[i-1] == wanted {
@@ -194,12 +543,12 @@ func xxTestDownloadEntitiesJson(t *testing.T) {
 		}
 	}
 
-	// Make sure there are no remaining entries in characterReferenceNames that
+	// Make sure there are no remaining entries in characterReferenceTable that
 	// should be deleted.
 
-	if len(characterReferenceNames) > 0 {
+	if len(remaining) > 0 {
 		t.Error("The following values are listed as character reference names, but they were not found in the entities.json list:",
-			characterReferenceNames)
+			remaining)
 	}
 
 	t.Log("Done.")
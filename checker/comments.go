@@ -0,0 +1,33 @@
+package checker
+
+import (
+	"strings"
+)
+
+// IsValidCommentText returns true if the argument is valid text for an HTML
+// comment, i.e. the part between "<!--" and "-->".
+//
+//     Comments must have the following format:
+//
+//      1. The string "<!--".
+//      2. Optionally, text, with the additional restriction that the text must
+//         not start with the string ">", nor start with the string "->", nor
+//         contain the strings "<!--", "-->", or "--!>", nor end with the
+//         string "<!-".
+//      3. The string "-->".
+//
+// From https://html.spec.whatwg.org/multipage/syntax.html#comments
+//
+func IsValidCommentText(text string) bool {
+
+	var invalid bool
+
+	invalid = strings.HasPrefix(text, ">") ||
+		strings.HasPrefix(text, "->") ||
+		strings.Contains(text, "<!--") ||
+		strings.Contains(text, "-->") ||
+		strings.Contains(text, "--!>") ||
+		strings.HasSuffix(text, "<!-")
+
+	return !invalid
+}
@@ -0,0 +1,53 @@
+package checker
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIsValidCommentText(t *testing.T) {
+	valid := []string{
+		"",
+		" a comment ",
+		"built from a1b2c3 -- clean",
+		"a->b",
+		"a > b",
+		"<!- ",
+		"--",
+		"-",
+		"<!",
+		"a-",
+	}
+	casesShouldBeTrue(t, valid, IsValidCommentText,
+		"Expecting %q to be valid comment text, but got false.")
+
+	invalid := []string{
+		">",
+		"> a",
+		"->",
+		"-> a",
+		"a <!-- b",
+		"a --> b",
+		"a --!> b",
+		"a <!-",
+		"<!-->",
+	}
+	casesShouldBeFalse(t, invalid, IsValidCommentText,
+		"Expecting %q to NOT be valid comment text, but got true.")
+}
+
+func ExampleIsValidCommentText() {
+	fmt.Println(IsValidCommentText(" Fix the --> in the footer "))
+	fmt.Println(IsValidCommentText(" Fix the footer "))
+	// Output:
+	// false
+	// true
+}
+
+// BenchmarkIsValidCommentText 20000000          45.7 ns/op         0 B/op        0 allocs/op
+func BenchmarkIsValidCommentText(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = IsValidCommentText(" built from a1b2c3 on 2014-03-01 ")
+	}
+}
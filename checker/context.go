@@ -0,0 +1,304 @@
+package checker
+
+import (
+	"github.com/Dancapistan/htmlutil/internal/charref"
+	"github.com/Dancapistan/htmlutil/internal/urlscheme"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Context is a place in an HTML document where text can go. Each context has
+// its own rules for what the text must not contain, and its own way of
+// escaping it. See IsValid, and Escape in the escaper package.
+//
+type Context int
+
+const (
+	// Text is the text content of a normal element, like a <p>. See
+	// IsValidText.
+	Text Context = iota
+
+	// RCDATA is the content of an escapable raw text element, <textarea> or
+	// <title>. Text is valid in RCDATA if it is valid in both elements. See
+	// IsValidEscapableRawText.
+	RCDATA
+
+	// RawText is the content of a <script> element. See IsValidRawText, which
+	// also covers <style> and the other raw text elements.
+	RawText
+
+	// Comment is the text of a comment, between "<!--" and "-->". See
+	// IsValidCommentText.
+	Comment
+
+	// AttrUnquoted is an unquoted attribute value. See
+	// IsValidAttributeValueUnquoted.
+	AttrUnquoted
+
+	// AttrSingle is an attribute value enclosed in single quotes. See
+	// IsValidAttributeValueSingleQuoted.
+	AttrSingle
+
+	// AttrDouble is an attribute value enclosed in double quotes. See
+	// IsValidAttributeValueDoubleQuoted.
+	AttrDouble
+
+	// URL is a URL in an attribute value enclosed in either kind of quote,
+	// like the value of href or src.
+	URL
+
+	// CSSString is the inside of a CSS string literal, enclosed in either kind
+	// of quote, in a <style> element or a quoted style attribute.
+	CSSString
+
+	// JSString is the inside of a JavaScript string literal, enclosed in either
+	// kind of quote, in a <script> element.
+	JSString
+
+	// CDATA is the content of a CDATA section in foreign content, like inline
+	// SVG. Unlike IsValidCDATAContent, it allows "]]>" when a new section
+	// starts right after it, so the text can span several sections.
+	CDATA
+)
+
+var contextNames = [...]string{
+	Text:         "Text",
+	RCDATA:       "RCDATA",
+	RawText:      "RawText",
+	Comment:      "Comment",
+	AttrUnquoted: "AttrUnquoted",
+	AttrSingle:   "AttrSingle",
+	AttrDouble:   "AttrDouble",
+	URL:          "URL",
+	CSSString:    "CSSString",
+	JSString:     "JSString",
+	CDATA:        "CDATA",
+}
+
+func (ctx Context) String() string {
+	if ctx < 0 || int(ctx) >= len(contextNames) {
+		return "Context(" + strconv.Itoa(int(ctx)) + ")"
+	}
+	return contextNames[ctx]
+}
+
+// IsValid returns true if the argument can be used as it is in the given
+// context. It returns false for an unknown context.
+//
+func IsValid(ctx Context, s string) bool {
+
+	switch ctx {
+	case Text:
+		return IsValidText(s)
+	case RCDATA:
+		return IsValidEscapableRawText("textarea", s) && IsValidEscapableRawText("title", s)
+	case RawText:
+		return IsValidRawText("script", s)
+	case Comment:
+		return IsValidCommentText(s)
+	case AttrUnquoted:
+		return IsValidAttributeValueUnquoted(s)
+	case AttrSingle:
+		return IsValidAttributeValueSingleQuoted(s)
+	case AttrDouble:
+		return IsValidAttributeValueDoubleQuoted(s)
+	case URL:
+		return isValidURLAttribute(s)
+	case CSSString:
+		return isValidCSSString(s)
+	case JSString:
+		return isValidJSString(s)
+	case CDATA:
+		return isValidCDATASections(s)
+	}
+	return false
+}
+
+// IsValidText returns true if the argument is valid text content for a normal
+// element, like a <p> or a <div>.
+//
+//     Normal elements can have text, character references, other elements,
+//     and comments, but the text must not contain the character "<" (U+003C)
+//     or an ambiguous ampersand.
+//
+// From https://html.spec.whatwg.org/multipage/syntax.html#elements-2
+//
+// Legacy character references without their semicolon, like "&copy 2014", are
+// not allowed either, because they are decoded anyway.
+//
+func IsValidText(s string) bool {
+
+	var invalid bool
+
+	invalid = strings.IndexByte(s, '<') != -1 ||
+		HasAmbiguousAmpersand(s) ||
+		HasLegacyCharacterReference(s)
+
+	return !invalid
+}
+
+// isValidURLAttribute returns true if the argument is a percent-encoded URL,
+// with a safe scheme or none, that is valid in either kind of quotes. The
+// scheme is the one the browser sees, after it decodes the character
+// references, so "javascript&#58;alert(1)" is not valid.
+//
+func isValidURLAttribute(s string) bool {
+
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(urlscheme.Characters, s[i]) == -1 {
+			return false
+		}
+	}
+
+	if !IsValidAttributeValueDoubleQuoted(s) || HasLegacyCharacterReference(s) {
+		return false
+	}
+
+	url := decodeURLAttribute(s)
+	scheme, _, ok := urlscheme.Parse(url)
+	return !ok || equalFoldASCII(url, "about:invalid") || urlscheme.IsSafe(scheme)
+}
+
+// decodeURLAttribute returns the URL a browser reads from the attribute value:
+// its character references are decoded, as by escaper.UnescapeAttributeValue,
+// and then its leading and trailing C0 controls and spaces, and all of its
+// tabs and newlines, are removed.
+//
+func decodeURLAttribute(val string) string {
+
+	var b []byte
+	for {
+		i := strings.IndexByte(val, UnicodeAmpersand)
+		if i == -1 {
+			b = append(b, val...)
+			break
+		}
+		b = append(b, val[:i]...)
+		val = val[i:]
+
+		r, second, n := decodeReference(val)
+		if n == 0 {
+			b = append(b, UnicodeAmpersand)
+			n = 1
+		} else {
+			b = utf8.AppendRune(b, r)
+			if second != 0 {
+				b = utf8.AppendRune(b, second)
+			}
+		}
+		val = val[n:]
+	}
+
+	url := strings.TrimFunc(string(b), func(r rune) bool { return r <= ' ' })
+	return strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\n' || r == '\r' {
+			return -1
+		}
+		return r
+	}, url)
+}
+
+// decodeReference decodes the character reference in an attribute value at the
+// start of s, which begins with an ampersand. It returns the code points, and
+// the number of bytes of s that were consumed, or 0 if s doesn't start with a
+// character reference. See decodeURLAttribute.
+//
+func decodeReference(s string) (first, second rune, n int) {
+
+	if len(s) < 2 || s[1] != '#' {
+
+		name, semicolon := MatchCharacterReference(s[1:])
+		if name == "" {
+			return 0, 0, 0
+		}
+
+		// A legacy reference followed by "=" or an alphanumeric isn't
+		// decoded in an attribute value.
+
+		n = len("&") + len(name)
+		if semicolon {
+			n++
+		} else if n < len(s) && charref.KeepsLegacyReference(s[n]) {
+			return 0, 0, 0
+		}
+		first, second, _ = CharacterReferenceRunes(name)
+		return first, second, n
+	}
+
+	value, n, _ := charref.ParseNumeric(s)
+	if n == 0 {
+		return 0, 0, 0
+	}
+	return charref.Decode(value), 0, n
+}
+
+// isValidCSSString returns true if the argument can go between the quotes of a
+// CSS string literal, in either kind of quote, in a <style> element or a
+// quoted style attribute.
+//
+//     A string token [...] consists of any sequence of code points other than
+//     the ending code point, newlines, and "\" (U+005C) that isn't part of a
+//     valid escape.
+//
+// From https://www.w3.org/TR/css-syntax-3/#consume-string-token
+//
+func isValidCSSString(s string) bool {
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++ // An escape, or an escaped newline.
+			if i == len(s) {
+				return false
+			}
+		case '"', '\'', '\n', '\r', '\f':
+			return false
+		}
+	}
+
+	return !ContainsEndTag(s, "style") && !HasAmbiguousAmpersand(s)
+}
+
+// isValidJSString returns true if the argument can go between the quotes of a
+// JavaScript string literal, in either kind of quote, in a <script> element.
+//
+func isValidJSString(s string) bool {
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++ // An escape, or a line continuation.
+			if i == len(s) {
+				return false
+			}
+		case '"', '\'', '\n', '\r':
+			return false
+		}
+	}
+
+	// The line terminators U+2028 and U+2029 end a string in older engines,
+	// and the script must not end, or hide its end tag, in the middle of the
+	// string.
+
+	return !strings.Contains(s, "\u2028") && !strings.Contains(s, "\u2029") &&
+		IndexEndTag(s, "script", "") == -1 && !strings.Contains(s, "<!--") &&
+		indexScriptOpen(s) == -1
+}
+
+// isValidCDATASections returns true if the argument can go between "<![CDATA["
+// and "]]>", where every "]]>" in it ends one section and starts the next.
+//
+func isValidCDATASections(s string) bool {
+
+	for {
+		i := strings.Index(s, "]]>")
+		if i == -1 {
+			return true
+		}
+		s = s[i+len("]]>"):]
+		if !strings.HasPrefix(s, "<![CDATA[") {
+			return false
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package checker
+
+import (
+	"testing"
+)
+
+func TestIsValid(t *testing.T) {
+
+	valid := map[Context][]string{
+		Text:         {"", "Tom &amp; Jerry", "a > b", "AT&T", "&copy;"},
+		RCDATA:       {"", "<b>", "a &lt;/textarea>", "AT&T"},
+		RawText:      {"", "if (a < b) {}", "'</scrip'"},
+		Comment:      {"", "a - b", "<!", "-"},
+		AttrUnquoted: {"a", "a&amp;b", "100%"},
+		AttrSingle:   {"", `"quoted"`, "a > b"},
+		AttrDouble:   {"", "it's", "a > b"},
+		URL:          {"", "/a/b?c=1&amp;d=2", "/x?javascript&#58;", "jav&eacute;script:x", "&#104;ttps://example.com/", "https://example.com/", "#top", "MAILTO:a@b", "about:invalid"},
+		CSSString:    {"", "a b", `\22 x\22 `, `\\`, `\3c /style>`},
+		JSString:     {"", "a b", `it\'s`, `\x3C/script>`, `\u2028`, `\\`},
+		CDATA:        {"", "a < b && c", "]]", "a]]]]><![CDATA[>b"},
+	}
+
+	invalid := map[Context][]string{
+		Text:         {"<b>", "&copy 2014", "&zzz;"},
+		RCDATA:       {"</textarea>", "</title>", "&zzz;"},
+		RawText:      {"</script>", "<!-- <script>"},
+		Comment:      {"-->", "<!--", "a--!>"},
+		AttrUnquoted: {"", "a b", "a=b", "a>"},
+		AttrSingle:   {"'", "&zzz;"},
+		AttrDouble:   {`"`, "&zzz;"},
+		URL:          {"javascript:alert(1)", "javascript&#58;alert(1)", "javascript&colon;alert(1)", "&#106;avascript:alert(1)", "&#x6A;avascript&#X3A;alert(1)", "java&Tab;script:alert(1)", "&#32;javascript:alert(1)", "&#1;javascript&#58alert(1)", "/a b", "/é", `/"`, "data:text/html,x", "/a?b=1&copy=2"},
+		CSSString:    {`"`, "'", "a\nb", `a\`, "</style>", "&zzz;"},
+		JSString:     {`"`, "'", "a\nb", `a\`, "</script>", "<!--", "a\u2028b"},
+		CDATA:        {"]]>", "a]]>b", "]]><![CDATA"},
+	}
+
+	for ctx, cases := range valid {
+		for _, s := range cases {
+			if !IsValid(ctx, s) {
+				t.Errorf("Expecting %q to be valid in context %v, but got false.", s, ctx)
+			}
+		}
+	}
+
+	for ctx, cases := range invalid {
+		for _, s := range cases {
+			if IsValid(ctx, s) {
+				t.Errorf("Expecting %q to NOT be valid in context %v, but got true.", s, ctx)
+			}
+		}
+	}
+
+	if IsValid(Context(-1), "") || IsValid(CDATA+1, "") {
+		t.Error("Expecting an unknown context to be invalid.")
+	}
+}
+
+func TestContext_String(t *testing.T) {
+
+	cases := map[Context]string{
+		Text:         "Text",
+		AttrDouble:   "AttrDouble",
+		CDATA:        "CDATA",
+		Context(-1):  "Context(-1)",
+		Context(100): "Context(100)",
+	}
+
+	for ctx, expected := range cases {
+		if actual := ctx.String(); actual != expected {
+			t.Errorf("Context(%d).String() is %q, but expected %q.", int(ctx), actual, expected)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+//go:build ignore
+// +build ignore
+
+// This program generates characternames_table.go from entities.json, the
+// WHATWG list of named character references. Run it with "go generate" from
+// the checker directory.
+//
+// To update to a new revision of the spec, replace entities.json with a fresh
+// copy of https://html.spec.whatwg.org/entities.json and run go generate. The
+// SHA-256 of entities.json is recorded in the generated file, and
+// TestCharacterReferencesMatchEntitiesJSON fails if the two drift apart.
+//
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"github.com/Dancapistan/htmlutil/internal/namehash"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+)
+
+const (
+	inputFile  = "entities.json"
+	outputFile = "characternames_table.go"
+	sourceURL  = "https://html.spec.whatwg.org/entities.json"
+)
+
+// entity is one entry of entities.json, e.g.
+//
+//     "&AElig;": { "codepoints": [198], "characters": "Æ" },
+//
+type entity struct {
+	Codepoints []rune `json:"codepoints"`
+	Characters string `json:"characters"`
+}
+
+func main() {
+
+	data, err := ioutil.ReadFile(inputFile)
+	if err != nil {
+		log.Fatalf("Unable to read %s: %s", inputFile, err)
+	}
+
+	var entities map[string]entity
+	if err := json.Unmarshal(data, &entities); err != nil {
+		log.Fatalf("Unable to parse %s: %s", inputFile, err)
+	}
+
+	// Each name with a semicolon is a character reference. Each name without a
+	// semicolon is a legacy character reference. Every legacy name is listed
+	// with a semicolon, too.
+
+	references := map[string][]rune{}
+	var names, legacy []string
+
+	for key, e := range entities {
+
+		if !strings.HasPrefix(key, "&") {
+			log.Fatalf("Entity %q doesn't start with an ampersand.", key)
+		}
+		if len(e.Codepoints) < 1 || len(e.Codepoints) > 2 {
+			log.Fatalf("Entity %q has %d code points; expected 1 or 2.", key, len(e.Codepoints))
+		}
+		if string(e.Codepoints) != e.Characters {
+			log.Fatalf("Entity %q has code points that don't match its characters.", key)
+		}
+
+		if strings.HasSuffix(key, ";") {
+			name := key[1 : len(key)-1]
+			names = append(names, name)
+			references[name] = e.Codepoints
+		} else {
+			legacy = append(legacy, key[1:])
+		}
+	}
+
+	var longestLegacy int
+	for _, name := range legacy {
+		if _, ok := entities["&"+name+";"]; !ok {
+			log.Fatalf("Legacy entity %q has no counterpart with a semicolon.", name)
+		}
+		if len(name) > longestLegacy {
+			longestLegacy = len(name)
+		}
+	}
+
+	sort.Strings(names)
+	sort.Strings(legacy)
+
+	// Place every name in the perfect hash table.
+
+	var longest, shortest int = 0, len(names[0])
+	for _, name := range names {
+		if len(name) > longest {
+			longest = len(name)
+		}
+		if len(name) < shortest {
+			shortest = len(name)
+		}
+	}
+
+	isLegacy := map[string]bool{}
+	for _, name := range legacy {
+		isLegacy[name] = true
+	}
+
+	// For each first byte, record which lengths of names start with it.
+
+	var lengths [128]uint32
+	for _, name := range names {
+		lengths[name[0]] |= 1 << uint(len(name))
+	}
+
+	seeds, slots := buildPerfectHash(names)
+
+	// Write out the Go source.
+
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "// Code generated by gen_characternames.go from %s; DO NOT EDIT.\n\n", inputFile)
+	fmt.Fprintf(&b, "package checker\n\n")
+
+	fmt.Fprintf(&b, "// entitiesJSONSource is where entities.json was downloaded from.\n")
+	fmt.Fprintf(&b, "const entitiesJSONSource = %q\n\n", sourceURL)
+	fmt.Fprintf(&b, "// entitiesJSONSHA256 is the SHA-256 of the entities.json this file was\n")
+	fmt.Fprintf(&b, "// generated from.\n")
+	fmt.Fprintf(&b, "const entitiesJSONSHA256 = \"%x\"\n\n", sha256.Sum256(data))
+
+	fmt.Fprintf(&b, "const (\n")
+	fmt.Fprintf(&b, "\tshortestCharacterReferenceName = %d\n", shortest)
+	fmt.Fprintf(&b, "\tlongestCharacterReferenceName = %d\n", longest)
+	fmt.Fprintf(&b, "\tlongestLegacyCharacterReferenceName = %d\n", longestLegacy)
+	fmt.Fprintf(&b, ")\n\n")
+
+	fmt.Fprintf(&b, "// characterReferenceLengths has bit l set in entry c if there is a name of\n")
+	fmt.Fprintf(&b, "// length l that starts with the byte c.\n")
+	fmt.Fprintf(&b, "var characterReferenceLengths = [128]uint32{\n")
+	for c, bits := range lengths {
+		if bits != 0 {
+			fmt.Fprintf(&b, "\t%q: 0x%08X,\n", c, bits)
+		}
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// characterReferenceSeeds holds the seed of each bucket of the perfect hash.\n")
+	fmt.Fprintf(&b, "// See characterReferenceIndex.\n")
+	fmt.Fprintf(&b, "var characterReferenceSeeds = [%d]uint32{", len(seeds))
+	for i, seed := range seeds {
+		if i%12 == 0 {
+			fmt.Fprintf(&b, "\n\t")
+		} else {
+			fmt.Fprintf(&b, " ")
+		}
+		fmt.Fprintf(&b, "%d,", seed)
+	}
+	fmt.Fprintf(&b, "\n}\n\n")
+
+	fmt.Fprintf(&b, "// characterReferenceTable holds every character reference, in perfect hash\n")
+	fmt.Fprintf(&b, "// order. See characterReferenceIndex.\n")
+	fmt.Fprintf(&b, "var characterReferenceTable = [%d]characterReference{\n", len(slots))
+	for _, name := range slots {
+		runes := references[name]
+		fmt.Fprintf(&b, "\t{%q, [2]rune{", name)
+		for i, r := range runes {
+			if i > 0 {
+				fmt.Fprintf(&b, ", ")
+			}
+			fmt.Fprintf(&b, "0x%04X", r)
+		}
+		fmt.Fprintf(&b, "}, %v},\n", isLegacy[name])
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("Unable to format generated source: %s", err)
+	}
+
+	if err := ioutil.WriteFile(outputFile, src, 0644); err != nil {
+		log.Fatalf("Unable to write %s: %s", outputFile, err)
+	}
+
+	log.Printf("Wrote %d names (%d legacy) to %s.", len(names), len(legacy), outputFile)
+}
+
+// buildPerfectHash builds a minimal perfect hash for names using the "hash,
+// displace, and compress" approach: names are grouped into buckets by their
+// hash, then, starting with the biggest bucket, each bucket gets the first seed
+// that moves all of its names into free slots.
+//
+// It returns the seed for each bucket and the name in each slot.
+//
+func buildPerfectHash(names []string) (seeds []uint32, slots []string) {
+
+	// Aim for about four names per bucket. The number of buckets must be a
+	// power of two.
+
+	numSeeds := 1
+	for numSeeds*4 < len(names) {
+		numSeeds *= 2
+	}
+
+	buckets := make([][]string, numSeeds)
+	for _, name := range names {
+		i := namehash.Bucket(namehash.Hash(name), numSeeds)
+		buckets[i] = append(buckets[i], name)
+	}
+
+	order := make([]int, numSeeds)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return len(buckets[order[a]]) > len(buckets[order[b]])
+	})
+
+	seeds = make([]uint32, numSeeds)
+	slots = make([]string, len(names))
+	taken := make([]bool, len(names))
+
+	for _, i := range order {
+		if len(buckets[i]) == 0 {
+			continue
+		}
+
+	search:
+		for seed := uint32(0); ; seed++ {
+			if seed == 1<<24 {
+				log.Fatalf("Unable to find a seed for bucket %d: %q", i, buckets[i])
+			}
+
+			var picked []int
+			for _, name := range buckets[i] {
+				j := namehash.Slot(namehash.Hash(name), seed, len(names))
+				if taken[j] {
+					continue search
+				}
+				for _, k := range picked {
+					if k == j {
+						continue search
+					}
+				}
+				picked = append(picked, j)
+			}
+
+			for n, j := range picked {
+				taken[j] = true
+				slots[j] = buckets[i][n]
+			}
+			seeds[i] = seed
+			break
+		}
+	}
+
+	return seeds, slots
+}
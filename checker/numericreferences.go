@@ -0,0 +1,173 @@
+package checker
+
+import (
+	"github.com/Dancapistan/htmlutil/internal/charref"
+)
+
+// CharacterReferenceError is the reason a character reference is not valid.
+// The String method returns the name of the matching parse error from the
+// WHATWG spec:
+//
+// https://html.spec.whatwg.org/multipage/parsing.html#parse-errors
+//
+type CharacterReferenceError int
+
+const (
+	// NoCharacterReferenceError means the character reference is valid.
+	NoCharacterReferenceError CharacterReferenceError = iota
+
+	// NotCharacterReference means the argument isn't shaped like a character
+	// reference at all, e.g. "amp", "&#;", or "&#65;x".
+	NotCharacterReference
+
+	// UnknownNamedCharacterReference means the argument looks like a named
+	// character reference, e.g. "&funky;", but the name isn't known.
+	UnknownNamedCharacterReference
+
+	// NullCharacterReference means the reference is to U+0000, e.g. "&#0;".
+	NullCharacterReference
+
+	// SurrogateCharacterReference means the reference is to a surrogate, e.g.
+	// "&#xD800;".
+	SurrogateCharacterReference
+
+	// NoncharacterCharacterReference means the reference is to a Unicode
+	// noncharacter, e.g. "&#xFFFF;".
+	NoncharacterCharacterReference
+
+	// ControlCharacterReference means the reference is to a control
+	// character other than ASCII whitespace, or to U+000D, e.g. "&#x80;".
+	ControlCharacterReference
+
+	// CharacterReferenceOutsideUnicodeRange means the reference is to a value
+	// beyond U+10FFFF, e.g. "&#1114112;".
+	CharacterReferenceOutsideUnicodeRange
+
+	// MissingSemicolonAfterCharacterReference means the reference is valid
+	// except for its missing semicolon, e.g. "&#65" or "&amp".
+	MissingSemicolonAfterCharacterReference
+)
+
+var characterReferenceErrorNames = [...]string{
+	NoCharacterReferenceError:               "",
+	NotCharacterReference:                   "not-a-character-reference",
+	UnknownNamedCharacterReference:          "unknown-named-character-reference",
+	NullCharacterReference:                  "null-character-reference",
+	SurrogateCharacterReference:             "surrogate-character-reference",
+	NoncharacterCharacterReference:          "noncharacter-character-reference",
+	ControlCharacterReference:               "control-character-reference",
+	CharacterReferenceOutsideUnicodeRange:   "character-reference-outside-unicode-range",
+	MissingSemicolonAfterCharacterReference: "missing-semicolon-after-character-reference",
+}
+
+func (e CharacterReferenceError) String() string {
+	if e < 0 || int(e) >= len(characterReferenceErrorNames) {
+		return "unknown-character-reference-error"
+	}
+	return characterReferenceErrorNames[e]
+}
+
+// IsValidNumericCharacterReference returns true if the argument is a valid
+// decimal (e.g. "&#9829;") or hexadecimal (e.g. "&#x2665;") character
+// reference. Otherwise, it returns false and the reason why, e.g.
+// SurrogateCharacterReference for "&#xD800;".
+//
+//     The ampersand must be followed by a "#" (U+0023) character, followed by
+//     one or more ASCII digits, representing a base-ten integer that
+//     corresponds to a code point that is allowed as follows, followed by a
+//     ";" (U+003B) character.
+//
+// Browsers still decode most invalid numeric references, but they silently
+// rewrite the ones to U+0000, to surrogates, to values beyond U+10FFFF, and to
+// most C1 control characters.
+//
+// From https://html.spec.whatwg.org/multipage/syntax.html#character-references
+//
+func IsValidNumericCharacterReference(ref string) (bool, CharacterReferenceError) {
+
+	value, n, semicolon := charref.ParseNumeric(ref)
+	if n == 0 || n != len(ref) {
+		return false, NotCharacterReference
+	}
+
+	if problem := codePointProblem(value); problem != NoCharacterReferenceError {
+		return false, problem
+	}
+
+	if !semicolon {
+		return false, MissingSemicolonAfterCharacterReference
+	}
+
+	return true, NoCharacterReferenceError
+}
+
+// IsAnyCharacterReference returns true if the argument is a valid named or
+// numeric character reference. Otherwise, it returns false and the reason
+// why. See IsCharacterReference and IsValidNumericCharacterReference.
+//
+func IsAnyCharacterReference(ref string) (bool, CharacterReferenceError) {
+
+	length := len(ref)
+	if length < 2 || ref[0] != UnicodeAmpersand {
+		return false, NotCharacterReference
+	}
+
+	if ref[1] == '#' {
+		return IsValidNumericCharacterReference(ref)
+	}
+
+	// Named references are one or more alphanumeric characters, optionally
+	// followed by a semicolon.
+
+	end := 1
+	for end < length && isASCIIAlphanumeric(ref[end]) {
+		end++
+	}
+
+	name := ref[1:end]
+	semicolon := end < length && ref[end] == UnicodeSemicolon
+	if semicolon {
+		end++
+	}
+
+	if len(name) == 0 || end != length {
+		return false, NotCharacterReference
+	}
+
+	switch {
+	case semicolon && IsCharacterReferenceName(name):
+		return true, NoCharacterReferenceError
+	case semicolon:
+		return false, UnknownNamedCharacterReference
+	case IsLegacyCharacterReferenceName(name):
+		return false, MissingSemicolonAfterCharacterReference
+	default:
+		return false, NotCharacterReference
+	}
+}
+
+// unicodeMaxRune is the largest Unicode code point, U+10FFFF.
+//
+const unicodeMaxRune = '\U0010FFFF'
+
+// codePointProblem returns the parse error for a numeric character reference
+// to the given code point, if any.
+//
+func codePointProblem(value rune) CharacterReferenceError {
+	switch {
+	case value == 0:
+		return NullCharacterReference
+	case value > unicodeMaxRune:
+		return CharacterReferenceOutsideUnicodeRange
+	case value >= 0xD800 && value <= 0xDFFF:
+		return SurrogateCharacterReference
+	case isUnicodeNonCharacter(value):
+		return NoncharacterCharacterReference
+	case value == '\u000D':
+		return ControlCharacterReference
+	case (value <= 0x1F || (value >= 0x7F && value <= 0x9F)) &&
+		value != '\u0009' && value != '\u000A' && value != '\u000C':
+		return ControlCharacterReference
+	}
+	return NoCharacterReferenceError
+}
@@ -0,0 +1,120 @@
+package checker
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIsValidNumericCharacterReference(t *testing.T) {
+
+	var cases = []struct {
+		Ref     string
+		Valid   bool
+		Problem CharacterReferenceError
+	}{
+		{"&#65;", true, NoCharacterReferenceError},
+		{"&#x41;", true, NoCharacterReferenceError},
+		{"&#X41;", true, NoCharacterReferenceError},
+		{"&#x1F600;", true, NoCharacterReferenceError},
+		{"&#9;", true, NoCharacterReferenceError},
+		{"&#10;", true, NoCharacterReferenceError},
+		{"&#0000065;", true, NoCharacterReferenceError},
+		{"&#0;", false, NullCharacterReference},
+		{"&#x0;", false, NullCharacterReference},
+		{"&#xD800;", false, SurrogateCharacterReference},
+		{"&#xDFFF;", false, SurrogateCharacterReference},
+		{"&#xFFFF;", false, NoncharacterCharacterReference},
+		{"&#xFDD0;", false, NoncharacterCharacterReference},
+		{"&#x80;", false, ControlCharacterReference},
+		{"&#13;", false, ControlCharacterReference},
+		{"&#1;", false, ControlCharacterReference},
+		{"&#127;", false, ControlCharacterReference},
+		{"&#1114112;", false, CharacterReferenceOutsideUnicodeRange},
+		{"&#x110000;", false, CharacterReferenceOutsideUnicodeRange},
+		{"&#99999999999999999999;", false, CharacterReferenceOutsideUnicodeRange},
+		{"&#65", false, MissingSemicolonAfterCharacterReference},
+		{"&#0", false, NullCharacterReference},
+		{"&#;", false, NotCharacterReference},
+		{"&#x;", false, NotCharacterReference},
+		{"&#", false, NotCharacterReference},
+		{"&#65;x", false, NotCharacterReference},
+		{"&#1a;", false, NotCharacterReference},
+		{"&amp;", false, NotCharacterReference},
+		{"#65;", false, NotCharacterReference},
+		{"", false, NotCharacterReference},
+	}
+
+	for _, c := range cases {
+		valid, problem := IsValidNumericCharacterReference(c.Ref)
+		if valid != c.Valid || problem != c.Problem {
+			t.Errorf("Expecting IsValidNumericCharacterReference(%q) to be %v, %v, but got %v, %v.",
+				c.Ref, c.Valid, c.Problem, valid, problem)
+		}
+	}
+}
+
+func ExampleIsValidNumericCharacterReference() {
+	for _, ref := range []string{"&#x1F600;", "&#xD800;", "&#65"} {
+		if ok, problem := IsValidNumericCharacterReference(ref); ok {
+			fmt.Println(ref, "is valid")
+		} else {
+			fmt.Println(ref, "is invalid:", problem)
+		}
+	}
+	// Output:
+	// &#x1F600; is valid
+	// &#xD800; is invalid: surrogate-character-reference
+	// &#65 is invalid: missing-semicolon-after-character-reference
+}
+
+// BenchmarkIsValidNumericCharacterReference  50000000         24.1 ns/op         0 B/op        0 allocs/op
+func BenchmarkIsValidNumericCharacterReference(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		IsValidNumericCharacterReference("&#x1F600;")
+	}
+}
+
+func TestIsAnyCharacterReference(t *testing.T) {
+
+	var cases = []struct {
+		Ref     string
+		Valid   bool
+		Problem CharacterReferenceError
+	}{
+		{"&amp;", true, NoCharacterReferenceError},
+		{"&CounterClockwiseContourIntegral;", true, NoCharacterReferenceError},
+		{"&#x1F600;", true, NoCharacterReferenceError},
+		{"&#0;", false, NullCharacterReference},
+		{"&amp", false, MissingSemicolonAfterCharacterReference},
+		{"&funky;", false, UnknownNamedCharacterReference},
+		{"&hearts", false, NotCharacterReference},
+		{"&amp;x", false, NotCharacterReference},
+		{"&;", false, NotCharacterReference},
+		{"&", false, NotCharacterReference},
+		{"amp;", false, NotCharacterReference},
+		{"", false, NotCharacterReference},
+	}
+
+	for _, c := range cases {
+		valid, problem := IsAnyCharacterReference(c.Ref)
+		if valid != c.Valid || problem != c.Problem {
+			t.Errorf("Expecting IsAnyCharacterReference(%q) to be %v, %v, but got %v, %v.",
+				c.Ref, c.Valid, c.Problem, valid, problem)
+		}
+	}
+}
+
+func ExampleIsAnyCharacterReference() {
+	for _, ref := range []string{"&hearts;", "&#x2665;", "&funky;"} {
+		if ok, problem := IsAnyCharacterReference(ref); ok {
+			fmt.Println(ref, "is valid")
+		} else {
+			fmt.Println(ref, "is invalid:", problem)
+		}
+	}
+	// Output:
+	// &hearts; is valid
+	// &#x2665; is valid
+	// &funky; is invalid: unknown-named-character-reference
+}
@@ -0,0 +1,196 @@
+package checker
+
+import (
+	"strings"
+)
+
+// EndTagTerminators are the characters that can follow the tag name of an end
+// tag: the space characters, ">" (U+003E), and "/" (U+002F).
+//
+const EndTagTerminators string = SpaceCharacters + ">/"
+
+// IsValidEscapableRawText returns true if the argument is valid content for the
+// escapable raw text element named by tag, which is "textarea" or "title".
+// Character references are decoded in escapable raw text, but nothing other
+// than the element's end tag ends it, so "<b>" is allowed but "</title>" isn't.
+//
+//     The text in raw text and escapable raw text elements must not contain
+//     any occurrences of the string "</" (U+003C LESS-THAN SIGN, U+002F
+//     SOLIDUS) followed by characters that case-insensitively match the tag
+//     name of the element followed by one of U+0009 CHARACTER TABULATION
+//     (tab), U+000A LINE FEED (LF), U+000C FORM FEED (FF), U+000D CARRIAGE
+//     RETURN (CR), U+0020 SPACE, U+003E GREATER-THAN SIGN (>), or U+002F
+//     SOLIDUS (/).
+//
+//     Escapable raw text elements can have text and character references,
+//     but the text must not contain an ambiguous ampersand.
+//
+// From https://html.spec.whatwg.org/multipage/syntax.html#cdata-rcdata-restrictions
+//
+// Note: The parser drops a newline at the very start of a <textarea>, so a
+// leading newline in the text is lost, even though it is valid.
+//
+func IsValidEscapableRawText(tag, text string) bool {
+	return !ContainsEndTag(text, tag) && !HasAmbiguousAmpersand(text)
+}
+
+// IsValidRawText returns true if the argument is valid content for the raw
+// text element named by tag, like "script" or "style". Nothing is decoded in
+// raw text, and nothing other than the element's end tag ends it, so the text
+// must not contain the end tag. See IsValidEscapableRawText.
+//
+// Script content has extra restrictions, because "<!--" followed by "<script"
+// puts the parser in a state where the next "</script>" doesn't end the
+// element, which swallows the rest of the document:
+//
+//     script        = outer *( comment-open inner comment-close outer )
+//
+//     outer         = < any string that doesn't contain a substring that
+//                     matches not-in-outer >
+//     not-in-outer  = comment-open
+//     inner         = < any string that doesn't contain a substring that
+//                     matches not-in-inner >
+//     not-in-inner  = comment-close / script-open
+//
+//     comment-open  = "<!--"
+//     comment-close = "-->"
+//     script-open   = "<" s c r i p t tag-end
+//
+// The letters of "script" match in any case, and tag-end is a tab, a newline,
+// a form feed, a space, "/", or ">".
+//
+// From https://html.spec.whatwg.org/multipage/scripting.html#restrictions-for-contents-of-script-elements
+//
+func IsValidRawText(tag, text string) bool {
+
+	if ContainsEndTag(text, tag) {
+		return false
+	}
+
+	if equalFoldASCII(tag, "script") {
+		return isValidScriptText(text)
+	}
+	return true
+}
+
+// scriptTagEnd are the characters that can follow "<script" to make a
+// script-open. See IsValidRawText.
+//
+const scriptTagEnd = "\u0009\u000A\u000C\u0020/>"
+
+// isValidScriptText returns true if the argument matches the script production
+// given in IsValidRawText.
+//
+func isValidScriptText(text string) bool {
+
+	for {
+
+		// In outer: look for a comment-open.
+
+		i := strings.Index(text, "<!--")
+		if i == -1 {
+			return true
+		}
+		text = text[i+len("<!--"):]
+
+		// In inner: there must be a comment-close, and no script-open before
+		// it.
+
+		end := strings.Index(text, "-->")
+		if end == -1 {
+			return false
+		}
+		if indexScriptOpen(text[:end]) != -1 {
+			return false
+		}
+		text = text[end+len("-->"):]
+	}
+}
+
+// indexScriptOpen returns the index of the first script-open in the argument,
+// or -1 if there isn't one.
+//
+func indexScriptOpen(text string) int {
+
+	for i := 0; ; {
+
+		j := strings.IndexByte(text[i:], '<')
+		if j == -1 {
+			return -1
+		}
+		start := i + j
+		end := start + len("<script")
+
+		if end < len(text) && equalFoldASCII(text[start+1:end], "script") &&
+			strings.IndexByte(scriptTagEnd, text[end]) != -1 {
+			return start
+		}
+
+		i = start + 1
+	}
+}
+
+// ContainsEndTag returns true if the text contains something that the parser
+// would read as the end tag of the element named by tag: "</", followed by the
+// tag name in any case, followed by one of the EndTagTerminators. For example,
+// "</Title>" and "</title " both end a title element.
+//
+func ContainsEndTag(text, tag string) bool {
+	return IndexEndTag(text, tag, EndTagTerminators) != -1
+}
+
+// INTERNAL USE ONLY. NO API GUARANTEES.
+//
+// IndexEndTag returns the index of the first "</" in the text that is followed
+// by the tag name in any case, followed by one of the characters in
+// terminators, or -1 if there isn't one. If terminators is empty, the tag name
+// doesn't need to be followed by anything.
+//
+func IndexEndTag(text, tag, terminators string) int {
+
+	for i := 0; ; {
+
+		j := strings.Index(text[i:], "</")
+		if j == -1 {
+			return -1
+		}
+		start := i + j
+		name := start + 2
+		end := name + len(tag)
+
+		if end <= len(text) && equalFoldASCII(text[name:end], tag) {
+			if terminators == "" {
+				return start
+			}
+			if end < len(text) && strings.IndexByte(terminators, text[end]) != -1 {
+				return start
+			}
+		}
+
+		i = name
+	}
+}
+
+// equalFoldASCII returns true if a and b are the same, ignoring the case of
+// ASCII letters only.
+//
+func equalFoldASCII(a, b string) bool {
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := 0; i < len(a); i++ {
+		if toLowerASCII(a[i]) != toLowerASCII(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func toLowerASCII(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + ('a' - 'A')
+	}
+	return c
+}
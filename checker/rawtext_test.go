@@ -0,0 +1,150 @@
+package checker
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIsValidEscapableRawText(t *testing.T) {
+
+	isValidTextarea := func(text string) bool {
+		return IsValidEscapableRawText("textarea", text)
+	}
+
+	valid := []string{
+		"",
+		"plain text",
+		"<b>bold</b>",
+		"\nleading newline",
+		"a < b &amp; c",
+		"</textareax>",
+		"</textarea",
+		"</text>",
+		"&copy 2014",
+	}
+	casesShouldBeTrue(t, valid, isValidTextarea,
+		"Expecting %q to be valid textarea content, but got false.")
+
+	invalid := []string{
+		"</textarea>",
+		"</TextArea>",
+		"a</textarea b",
+		"</textarea/",
+		"</textarea\n",
+		"&dan;",
+	}
+	casesShouldBeFalse(t, invalid, isValidTextarea,
+		"Expecting %q to NOT be valid textarea content, but got true.")
+
+	if !IsValidEscapableRawText("title", "</textarea>") {
+		t.Errorf("Expecting %q to be valid title content, but got false.", "</textarea>")
+	}
+	if IsValidEscapableRawText("title", "</TITLE>") {
+		t.Errorf("Expecting %q to NOT be valid title content, but got true.", "</TITLE>")
+	}
+}
+
+func TestIsValidRawText(t *testing.T) {
+
+	isValidScript := func(text string) bool {
+		return IsValidRawText("script", text)
+	}
+
+	validScripts := []string{
+		"",
+		"var a = 1 < 2;",
+		`document.write("<b>hi</b>")`,
+		`var s = "<\/script>";`,
+		"<!-- old-school hiding -->",
+		"<!-- <scripts> -->",
+		"<!-- a --> <script> b",
+		"<!-- a --> <!-- b -->",
+		"</scripts>",
+		"-->",
+	}
+	casesShouldBeTrue(t, validScripts, isValidScript,
+		"Expecting %q to be valid script content, but got false.")
+
+	invalidScripts := []string{
+		"</script>",
+		`var s = "</SCRIPT>";`,
+		"a </script\n",
+		"<!-- never closed",
+		"<!--<script>-->",
+		"<!-- <SCRIPT/ -->",
+		"<!-- ok --> <!-- <script ",
+	}
+	casesShouldBeFalse(t, invalidScripts, isValidScript,
+		"Expecting %q to NOT be valid script content, but got true.")
+
+	isValidStyle := func(text string) bool {
+		return IsValidRawText("style", text)
+	}
+
+	validStyles := []string{
+		"",
+		"p { color: red }",
+		"a::after { content: '\\3C /style>' }",
+		"<!-- p { color: red } <script>",
+	}
+	casesShouldBeTrue(t, validStyles, isValidStyle,
+		"Expecting %q to be valid style content, but got false.")
+
+	invalidStyles := []string{
+		"</style>",
+		"a::after { content: '</Style>' }",
+	}
+	casesShouldBeFalse(t, invalidStyles, isValidStyle,
+		"Expecting %q to NOT be valid style content, but got true.")
+}
+
+func ExampleIsValidRawText() {
+	fmt.Println(IsValidRawText("script", `var s = "</script>";`))
+	fmt.Println(IsValidRawText("script", `var s = "<!-- <script>";`))
+	fmt.Println(IsValidRawText("script", `var s = "<\/script>";`))
+	// Output:
+	// false
+	// false
+	// true
+}
+
+func TestIndexEndTag(t *testing.T) {
+
+	cases := []struct {
+		text, tag, terminators string
+		expected               int
+	}{
+		{"", "title", EndTagTerminators, -1},
+		{"</title>", "title", EndTagTerminators, 0},
+		{"a</ title>", "title", EndTagTerminators, -1},
+		{"</</title ", "title", EndTagTerminators, 2},
+		{"</titlex></title>", "title", EndTagTerminators, 9},
+		{"</title", "title", EndTagTerminators, -1},
+		{"</title", "title", "", 0},
+		{"</TITLEx", "title", "", 0},
+		{"</titl", "title", "", -1},
+	}
+
+	for _, c := range cases {
+		if actual := IndexEndTag(c.text, c.tag, c.terminators); actual != c.expected {
+			t.Errorf("Expecting IndexEndTag(%q, %q, %q) to be %d, got %d.",
+				c.text, c.tag, c.terminators, c.expected, actual)
+		}
+	}
+}
+
+func ExampleIsValidEscapableRawText() {
+	fmt.Println(IsValidEscapableRawText("title", "<b>Bold</b> &amp; brash"))
+	fmt.Println(IsValidEscapableRawText("title", "The </title> tag"))
+	// Output:
+	// true
+	// false
+}
+
+// BenchmarkIsValidEscapableRawText 10000000          94.7 ns/op         0 B/op        0 allocs/op
+func BenchmarkIsValidEscapableRawText(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = IsValidEscapableRawText("textarea", "Some <b>text</b> &amp; an </textarea")
+	}
+}
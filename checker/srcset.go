@@ -0,0 +1,582 @@
+package checker
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ImageCandidate is one image candidate string of a srcset attribute: a URL
+// and at most one descriptor. A candidate without a descriptor has a density
+// of 1, but ParseSrcset leaves both descriptor fields zero for it, so it can
+// be written back the way it was.
+//
+type ImageCandidate struct {
+	URL     string
+	Width   int     // The width descriptor, like 640 for "640w", or 0.
+	Density float64 // The pixel density descriptor, like 2 for "2x", or 0.
+}
+
+// SourceSize is one source size of a sizes attribute: an optional media
+// condition and a size.
+//
+type SourceSize struct {
+	Condition string // The media condition, like "(max-width: 600px)", or "".
+	Size      string // The size, like "50vw" or "calc(100vw - 2rem)", or "auto".
+}
+
+// SrcsetProblem is the reason a srcset or sizes attribute is not valid. The
+// String method returns a short name for it, like "invalid-image-descriptor".
+//
+type SrcsetProblem int
+
+const (
+	// NoImageCandidates means the srcset has no image candidate strings.
+	NoImageCandidates SrcsetProblem = iota
+
+	// EmptyImageCandidate means there are extra commas, e.g. "a.png,, b.png".
+	EmptyImageCandidate
+
+	// InvalidImageDescriptor means a descriptor is not a positive width, like
+	// "640w", or a positive density, like "1.5x". The candidate is dropped.
+	InvalidImageDescriptor
+
+	// DuplicateImageDescriptor means a candidate has more than one descriptor,
+	// e.g. "a.png 640w 2x". The candidate is dropped.
+	DuplicateImageDescriptor
+
+	// MixedImageDescriptors means some candidates have width descriptors and
+	// some don't.
+	MixedImageDescriptors
+
+	// DuplicateImageCandidate means two candidates have the same width or the
+	// same density.
+	DuplicateImageCandidate
+
+	// EmptySourceSize means there are extra commas, e.g. "50vw,, 100vw".
+	EmptySourceSize
+
+	// InvalidSourceSizeValue means a size is not a non-negative CSS length, a
+	// math function like calc(), or "auto".
+	InvalidSourceSizeValue
+
+	// InvalidMediaCondition means a media condition is not in parentheses, or
+	// its parentheses don't match.
+	InvalidMediaCondition
+
+	// MissingMediaCondition means a source size other than the last has no
+	// media condition, so the ones after it are never used.
+	MissingMediaCondition
+
+	// ExtraMediaCondition means the last source size has a media condition.
+	ExtraMediaCondition
+)
+
+var srcsetProblemNames = [...]string{
+	NoImageCandidates:        "no-image-candidates",
+	EmptyImageCandidate:      "empty-image-candidate",
+	InvalidImageDescriptor:   "invalid-image-descriptor",
+	DuplicateImageDescriptor: "duplicate-image-descriptor",
+	MixedImageDescriptors:    "mixed-image-descriptors",
+	DuplicateImageCandidate:  "duplicate-image-candidate",
+	EmptySourceSize:          "empty-source-size",
+	InvalidSourceSizeValue:   "invalid-source-size-value",
+	InvalidMediaCondition:    "invalid-media-condition",
+	MissingMediaCondition:    "missing-media-condition",
+	ExtraMediaCondition:      "extra-media-condition",
+}
+
+func (p SrcsetProblem) String() string {
+	if p < 0 || int(p) >= len(srcsetProblemNames) {
+		return "unknown-srcset-problem"
+	}
+	return srcsetProblemNames[p]
+}
+
+// SrcsetError is a problem with a srcset or sizes attribute, and the part of
+// the attribute it was found in.
+//
+type SrcsetError struct {
+	Problem SrcsetProblem
+	Text    string
+}
+
+func (e *SrcsetError) Error() string {
+	return "checker: " + e.Problem.String() + " in " + strconv.Quote(e.Text)
+}
+
+// ParseSrcset parses the value of a srcset attribute, like
+// "small.png 480w, large.png 1080w", into its image candidates, and returns
+// them along with the reasons the value is not valid, as *SrcsetErrors. The
+// candidates are the ones a browser would use: a candidate with a bad
+// descriptor is dropped, but the rest of the value is still parsed.
+//
+//     A srcset attribute [...] must consist of one or more image candidate
+//     strings, each separated from the next by a U+002C COMMA character (,).
+//
+//     An image candidate string consists of the following components, in
+//     order:
+//
+//      1. Zero or more ASCII whitespace.
+//      2. A valid non-empty URL that does not start or end with a U+002C
+//         COMMA character (,), referencing a non-interactive, optionally
+//         animated, image resource that is neither paged nor scripted.
+//      3. Zero or more ASCII whitespace.
+//      4. Zero or one of the following: a width descriptor [...] or a pixel
+//         density descriptor [...].
+//      5. Zero or more ASCII whitespace.
+//
+//     There must not be an image candidate string for an element that has
+//     the same width descriptor value as another image candidate string's
+//     width descriptor value for the same element.
+//
+//     There must not be an image candidate string for an element that has
+//     the same pixel density descriptor value as another image candidate
+//     string's pixel density descriptor value for the same element. For the
+//     purpose of this requirement, an image candidate string with no
+//     descriptors is equivalent to an image candidate string with a 1x
+//     descriptor.
+//
+//     If an image candidate string for an element has the width descriptor
+//     specified, all other image candidate strings for that element must also
+//     have the width descriptor specified.
+//
+// From https://html.spec.whatwg.org/multipage/images.html#srcset-attributes
+//
+func ParseSrcset(s string) (candidates []ImageCandidate, errs []error) {
+
+	// This follows "parse a srcset attribute" from
+	// https://html.spec.whatwg.org/multipage/images.html#parse-a-srcset-attribute
+
+	fail := func(problem SrcsetProblem, text string) {
+		errs = append(errs, &SrcsetError{Problem: problem, Text: text})
+	}
+
+	for pos := 0; ; {
+
+		// Skip whitespace and commas. Commas here are empty candidates.
+
+		start := pos
+		for pos < len(s) && (isSpaceCharacter(s[pos]) || s[pos] == ',') {
+			pos++
+		}
+		if strings.IndexByte(s[start:pos], ',') != -1 {
+			fail(EmptyImageCandidate, s[start:pos])
+		}
+		if pos == len(s) {
+			break
+		}
+
+		// The URL runs up to the next whitespace. Commas at the end of it are
+		// separators.
+
+		candidateStart := pos
+		for pos < len(s) && !isSpaceCharacter(s[pos]) {
+			pos++
+		}
+		url := s[candidateStart:pos]
+
+		var descriptors []string
+		if trimmed := strings.TrimRight(url, ","); len(trimmed) < len(url) {
+			if len(url)-len(trimmed) > 1 {
+				fail(EmptyImageCandidate, url[len(trimmed):])
+			}
+			url = trimmed
+		} else {
+			descriptors, pos = tokenizeImageDescriptors(s, pos)
+		}
+
+		candidate := ImageCandidate{URL: url}
+		text := strings.Trim(s[candidateStart:pos], SpaceCharacters+",")
+		if problem, ok := parseImageDescriptors(descriptors, &candidate); !ok {
+			fail(problem, text)
+			continue
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	if len(candidates) == 0 && len(errs) == 0 {
+		fail(NoImageCandidates, s)
+	}
+
+	// Check the rules between candidates.
+
+	mixed := false
+	for i, c := range candidates {
+		if !mixed && (c.Width > 0) != (candidates[0].Width > 0) {
+			fail(MixedImageDescriptors, c.URL)
+			mixed = true
+		}
+		for _, other := range candidates[:i] {
+			if c.Width > 0 && c.Width == other.Width ||
+				c.Width == 0 && other.Width == 0 && imageDensity(c) == imageDensity(other) {
+				fail(DuplicateImageCandidate, c.URL)
+				break
+			}
+		}
+	}
+
+	return candidates, errs
+}
+
+// IsValidSrcset returns true if ParseSrcset finds no problems in the argument.
+//
+func IsValidSrcset(s string) bool {
+	_, errs := ParseSrcset(s)
+	return len(errs) == 0
+}
+
+// imageDensity returns the density of the candidate, counting no descriptor as
+// 1x.
+//
+func imageDensity(c ImageCandidate) float64 {
+	if c.Density == 0 && c.Width == 0 {
+		return 1
+	}
+	return c.Density
+}
+
+// tokenizeImageDescriptors splits the descriptors that start at pos, up to the
+// comma that ends the candidate, and returns them and the position after that
+// comma. Commas inside parentheses don't end the candidate.
+//
+func tokenizeImageDescriptors(s string, pos int) (descriptors []string, end int) {
+
+	// Each descriptor is a run of s, so it can be sliced out rather than
+	// built up.
+
+	tokenStart := -1
+	push := func() {
+		if tokenStart != -1 {
+			descriptors = append(descriptors, s[tokenStart:pos])
+			tokenStart = -1
+		}
+	}
+
+	inParens := false
+	for ; pos < len(s); pos++ {
+		c := s[pos]
+		switch {
+		case inParens:
+			inParens = c != ')'
+		case isSpaceCharacter(c):
+			push()
+		case c == ',':
+			push()
+			return descriptors, pos + 1
+		default:
+			if tokenStart == -1 {
+				tokenStart = pos
+			}
+			inParens = c == '('
+		}
+	}
+	push()
+	return descriptors, pos
+}
+
+// parseImageDescriptors sets the descriptor fields of the candidate. If one of
+// the descriptors is not valid, it returns the problem and false.
+//
+func parseImageDescriptors(descriptors []string, candidate *ImageCandidate) (SrcsetProblem, bool) {
+
+	for _, d := range descriptors {
+
+		value, kind := d[:len(d)-1], d[len(d)-1]
+
+		if candidate.Width > 0 || candidate.Density > 0 {
+			return DuplicateImageDescriptor, false
+		}
+
+		switch kind {
+		case 'w':
+			width, ok := parseNonNegativeInteger(value)
+			if !ok || width == 0 {
+				return InvalidImageDescriptor, false
+			}
+			candidate.Width = width
+		case 'x':
+			density, ok := parseFloatingPointNumber(value)
+			if !ok || density <= 0 {
+				return InvalidImageDescriptor, false
+			}
+			candidate.Density = density
+		default:
+			return InvalidImageDescriptor, false
+		}
+	}
+	return 0, true
+}
+
+// parseNonNegativeInteger parses a valid non-negative integer: one or more
+// ASCII digits.
+//
+func parseNonNegativeInteger(s string) (int, bool) {
+	if len(s) == 0 || strings.Trim(s, "0123456789") != "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
+
+// parseFloatingPointNumber parses a valid floating-point number.
+//
+//     A string is a valid floating-point number if it consists of:
+//
+//      1. Optionally, a U+002D HYPHEN-MINUS character (-).
+//      2. One or both of the following, in the given order:
+//          1. A series of one or more ASCII digits.
+//          2. Both of the following, in the given order:
+//              1. A single U+002E FULL STOP character (.).
+//              2. A series of one or more ASCII digits.
+//      3. Optionally:
+//          1. Either a U+0065 LATIN SMALL LETTER E character (e) or a U+0045
+//             LATIN CAPITAL LETTER E character (E).
+//          2. Optionally, a U+002D HYPHEN-MINUS character (-) or U+002B PLUS
+//             SIGN character (+).
+//          3. A series of one or more ASCII digits.
+//
+// From https://html.spec.whatwg.org/multipage/common-microsyntaxes.html#valid-floating-point-number
+//
+func parseFloatingPointNumber(s string) (float64, bool) {
+
+	rest := strings.TrimPrefix(s, "-")
+	whole := skipDigits(rest)
+	rest = rest[whole:]
+	fraction := 0
+	if strings.HasPrefix(rest, ".") {
+		fraction = skipDigits(rest[1:])
+		if fraction == 0 {
+			return 0, false
+		}
+		rest = rest[1+fraction:]
+	}
+	if whole == 0 && fraction == 0 {
+		return 0, false
+	}
+	if len(rest) > 0 && (rest[0] == 'e' || rest[0] == 'E') {
+		rest = rest[1:]
+		if len(rest) > 0 && (rest[0] == '-' || rest[0] == '+') {
+			rest = rest[1:]
+		}
+		exponent := skipDigits(rest)
+		if exponent == 0 {
+			return 0, false
+		}
+		rest = rest[exponent:]
+	}
+	if rest != "" {
+		return 0, false
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	return f, err == nil
+}
+
+// skipDigits returns the number of ASCII digits at the start of s.
+//
+func skipDigits(s string) int {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return i
+}
+
+func isSpaceCharacter(c byte) bool {
+	return strings.IndexByte(SpaceCharacters, c) != -1
+}
+
+// ParseSizes parses the value of a sizes attribute, like
+// "(max-width: 600px) 100vw, 50vw", into its source sizes, and returns them
+// along with the reasons the value is not valid, as *SrcsetErrors. A source
+// size with a bad size is dropped.
+//
+//     <source-size-list> = [ <source-size># , ]? <source-size-value>
+//     <source-size> = <media-condition> <source-size-value>
+//     <source-size-value> = <length> | auto
+//
+//     The <source-size-value> must not be negative, and must not use CSS
+//     functions other than the math functions.
+//
+// From https://html.spec.whatwg.org/multipage/images.html#sizes-attributes
+//
+// The media conditions are only checked for matching parentheses.
+//
+func ParseSizes(s string) (sizes []SourceSize, errs []error) {
+
+	fail := func(problem SrcsetProblem, text string) {
+		errs = append(errs, &SrcsetError{Problem: problem, Text: text})
+	}
+
+	entries := splitTopLevelCommas(s)
+	for i, entry := range entries {
+
+		entry = strings.Trim(entry, SpaceCharacters)
+		if entry == "" {
+			fail(EmptySourceSize, s)
+			continue
+		}
+
+		cut := lastComponent(entry)
+		size := SourceSize{
+			Condition: strings.TrimRight(entry[:cut], SpaceCharacters),
+			Size:      entry[cut:],
+		}
+
+		if !isValidSourceSizeValue(size.Size) {
+			fail(InvalidSourceSizeValue, entry)
+			continue
+		}
+
+		last := i == len(entries)-1
+		switch {
+		case size.Condition != "" && !isBalancedMediaCondition(size.Condition):
+			fail(InvalidMediaCondition, entry)
+		case size.Condition == "" && !last:
+			fail(MissingMediaCondition, entry)
+		case size.Condition != "" && last:
+			fail(ExtraMediaCondition, entry)
+		}
+		sizes = append(sizes, size)
+	}
+
+	return sizes, errs
+}
+
+// IsValidSizes returns true if ParseSizes finds no problems in the argument.
+//
+func IsValidSizes(s string) bool {
+	_, errs := ParseSizes(s)
+	return len(errs) == 0
+}
+
+// splitTopLevelCommas splits s at the commas that aren't inside parentheses.
+//
+func splitTopLevelCommas(s string) []string {
+
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// lastComponent returns the index where the last component value of the
+// trimmed entry starts. A function like "calc(100vw - 2em)" is one component,
+// spaces and all.
+//
+func lastComponent(entry string) int {
+
+	i := len(entry)
+	if strings.HasSuffix(entry, ")") {
+		depth := 0
+		for i--; i >= 0; i-- {
+			if entry[i] == ')' {
+				depth++
+			} else if entry[i] == '(' {
+				depth--
+				if depth == 0 {
+					break
+				}
+			}
+		}
+		if i < 0 {
+			return 0
+		}
+	}
+	for i > 0 && !isSpaceCharacter(entry[i-1]) && entry[i-1] != ')' && entry[i-1] != '(' {
+		i--
+	}
+	return i
+}
+
+// mathFunctions are the CSS functions allowed in a source size value.
+//
+var mathFunctions = []string{"calc(", "min(", "max(", "clamp("}
+
+// lengthUnits are the CSS length units.
+//
+var lengthUnits = []string{
+	"em", "rem", "ex", "rex", "cap", "rcap", "ch", "rch", "ic", "ric", "lh", "rlh",
+	"vw", "vh", "vi", "vb", "vmin", "vmax",
+	"svw", "svh", "svi", "svb", "svmin", "svmax",
+	"lvw", "lvh", "lvi", "lvb", "lvmin", "lvmax",
+	"dvw", "dvh", "dvi", "dvb", "dvmin", "dvmax",
+	"cqw", "cqh", "cqi", "cqb", "cqmin", "cqmax",
+	"cm", "mm", "q", "in", "pt", "pc", "px",
+}
+
+// isValidSourceSizeValue returns true if the argument is "auto", a math
+// function, or a non-negative CSS length.
+//
+func isValidSourceSizeValue(size string) bool {
+
+	if equalFoldASCII(size, "auto") {
+		return true
+	}
+
+	for _, fn := range mathFunctions {
+		if len(size) > len(fn) && equalFoldASCII(size[:len(fn)], fn) {
+			return strings.HasSuffix(size, ")")
+		}
+	}
+
+	// A CSS number, without a sign, followed by a unit. Only zero can omit the
+	// unit.
+
+	number := skipDigits(size)
+	if number < len(size) && size[number] == '.' {
+		fraction := skipDigits(size[number+1:])
+		if fraction == 0 {
+			return false
+		}
+		number += 1 + fraction
+	}
+	if number == 0 {
+		return false
+	}
+
+	unit := size[number:]
+	if unit == "" {
+		return strings.Trim(size, "0.") == ""
+	}
+	for _, u := range lengthUnits {
+		if equalFoldASCII(unit, u) {
+			return true
+		}
+	}
+	return false
+}
+
+// isBalancedMediaCondition returns true if the condition has parentheses, and
+// they match.
+//
+func isBalancedMediaCondition(condition string) bool {
+
+	depth := 0
+	for i := 0; i < len(condition); i++ {
+		switch condition[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return false
+			}
+		}
+	}
+	return depth == 0 && strings.Contains(condition, "(")
+}
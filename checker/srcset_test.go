@@ -0,0 +1,170 @@
+package checker
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseSrcset(t *testing.T) {
+
+	cases := map[string][]ImageCandidate{
+		"a.png":                      {{URL: "a.png"}},
+		"  a.png  ":                  {{URL: "a.png"}},
+		"a.png 2x":                   {{URL: "a.png", Density: 2}},
+		"a.png 1.5x, b.png 3x":       {{URL: "a.png", Density: 1.5}, {URL: "b.png", Density: 3}},
+		"a.png 480w,b.png 1080w":     {{URL: "a.png", Width: 480}, {URL: "b.png", Width: 1080}},
+		"a.png,b.png 2x":             {{URL: "a.png,b.png", Density: 2}},
+		"a.png, b.png 2x":            {{URL: "a.png"}, {URL: "b.png", Density: 2}},
+		"a.png\t480w,\nb.png\t960w ": {{URL: "a.png", Width: 480}, {URL: "b.png", Width: 960}},
+		"/img?w=1,2 1e1x":            {{URL: "/img?w=1,2", Density: 10}},
+		"a.png 2x (a, b), b.png 3x":  {{URL: "b.png", Density: 3}},
+	}
+
+	for input, expected := range cases {
+		actual, _ := ParseSrcset(input)
+		if fmt.Sprint(actual) != fmt.Sprint(expected) {
+			t.Errorf("ParseSrcset(%q) is %v, but expected %v.", input, actual, expected)
+		}
+	}
+}
+
+func TestParseSrcset_errors(t *testing.T) {
+
+	cases := map[string][]SrcsetProblem{
+		"a.png 1x, b.png 2x":        nil,
+		"a.png 480w, b.png 960w":    nil,
+		"a.png, b.png 2x":           nil,
+		"":                          {NoImageCandidates},
+		"   ":                       {NoImageCandidates},
+		", a.png":                   {EmptyImageCandidate},
+		"a.png,, b.png 2x":          {EmptyImageCandidate},
+		"a.png 1x, , b.png 2x":      {EmptyImageCandidate},
+		"a.png 2y":                  {InvalidImageDescriptor},
+		"a.png 0w":                  {InvalidImageDescriptor},
+		"a.png -1x":                 {InvalidImageDescriptor},
+		"a.png 0x":                  {InvalidImageDescriptor},
+		"a.png +2x":                 {InvalidImageDescriptor},
+		"a.png 1.x":                 {InvalidImageDescriptor},
+		"a.png w":                   {InvalidImageDescriptor},
+		"a.png 480w 2x":             {DuplicateImageDescriptor},
+		"a.png 480w, b.png":         {MixedImageDescriptors},
+		"a.png 2x, b.png 480w":      {MixedImageDescriptors},
+		"a.png, b.png 1x":           {DuplicateImageCandidate},
+		"a.png 2x, b.png 2.0x":      {DuplicateImageCandidate},
+		"a.png 480w, b.png 480w":    {DuplicateImageCandidate},
+		"a.png 1x 2x, b.png 480w":   {DuplicateImageDescriptor},
+		"a.png 0w, b.png 1x, c.png": {InvalidImageDescriptor, DuplicateImageCandidate},
+	}
+
+	for input, expected := range cases {
+		_, errs := ParseSrcset(input)
+		var actual []SrcsetProblem
+		for _, err := range errs {
+			actual = append(actual, err.(*SrcsetError).Problem)
+		}
+		if fmt.Sprint(actual) != fmt.Sprint(expected) {
+			t.Errorf("ParseSrcset(%q) has problems %v, but expected %v.", input, actual, expected)
+		}
+	}
+}
+
+func TestIsValidSrcset(t *testing.T) {
+	valid := []string{
+		"a.png",
+		"a.png 1x, a@2x.png 2x",
+		"small.jpg 480w, large.jpg 1080w",
+	}
+	casesShouldBeTrue(t, valid, IsValidSrcset,
+		"Expecting %q to be a valid srcset, but got false.")
+
+	invalid := []string{
+		"",
+		"a.png 1x, b.png",
+		"a.png 480",
+		"a.png 480w, b.png 2x",
+	}
+	casesShouldBeFalse(t, invalid, IsValidSrcset,
+		"Expecting %q to NOT be a valid srcset, but got true.")
+}
+
+func TestParseSizes(t *testing.T) {
+
+	cases := map[string][]SourceSize{
+		"100vw":                          {{Size: "100vw"}},
+		"auto":                           {{Size: "auto"}},
+		"(max-width: 600px) 100vw, 50vw": {{"(max-width: 600px)", "100vw"}, {"", "50vw"}},
+		"(min-width: 40em) calc(50vw - 2rem), 100vw": {{"(min-width: 40em)", "calc(50vw - 2rem)"}, {"", "100vw"}},
+		"not (orientation: portrait) 300px, 0":       {{"not (orientation: portrait)", "300px"}, {"", "0"}},
+		"(max-width: 600px) 50%, 100vw":              {{"", "100vw"}},
+	}
+
+	for input, expected := range cases {
+		actual, _ := ParseSizes(input)
+		if fmt.Sprint(actual) != fmt.Sprint(expected) {
+			t.Errorf("ParseSizes(%q) is %v, but expected %v.", input, actual, expected)
+		}
+	}
+}
+
+func TestParseSizes_errors(t *testing.T) {
+
+	cases := map[string][]SrcsetProblem{
+		"100vw":                            nil,
+		"(max-width: 600px) 100vw, 33.3vw": nil,
+		"(min-width: 1px) min(50vw, 800px), 100vw": nil,
+		"":                                {EmptySourceSize},
+		"50vw,, 100vw":                    {MissingMediaCondition, EmptySourceSize},
+		"-10px":                           {InvalidSourceSizeValue},
+		"50%":                             {InvalidSourceSizeValue},
+		"10":                              {InvalidSourceSizeValue},
+		"10furlongs":                      {InvalidSourceSizeValue},
+		"50vw, 100vw":                     {MissingMediaCondition},
+		"(max-width: 600px) 100vw":        {ExtraMediaCondition},
+		"max-width: 600px 100vw, 50vw":    {InvalidMediaCondition},
+		"(max-width: 600px)) 100vw, 50vw": {InvalidMediaCondition},
+	}
+
+	for input, expected := range cases {
+		_, errs := ParseSizes(input)
+		var actual []SrcsetProblem
+		for _, err := range errs {
+			actual = append(actual, err.(*SrcsetError).Problem)
+		}
+		if fmt.Sprint(actual) != fmt.Sprint(expected) {
+			t.Errorf("ParseSizes(%q) has problems %v, but expected %v.", input, actual, expected)
+		}
+	}
+}
+
+func ExampleParseSrcset() {
+	candidates, errs := ParseSrcset("small.jpg 480w, large.jpg 1080w, huge.jpg 2x")
+	for _, c := range candidates {
+		fmt.Println(c.URL, c.Width, c.Density)
+	}
+	for _, err := range errs {
+		fmt.Println(err)
+	}
+	// Output:
+	// small.jpg 480 0
+	// large.jpg 1080 0
+	// huge.jpg 0 2
+	// checker: mixed-image-descriptors in "huge.jpg"
+}
+
+func ExampleParseSizes() {
+	sizes, _ := ParseSizes("(max-width: 600px) 100vw, 50vw")
+	for _, size := range sizes {
+		fmt.Printf("%q %q\n", size.Condition, size.Size)
+	}
+	// Output:
+	// "(max-width: 600px)" "100vw"
+	// "" "50vw"
+}
+
+// BenchmarkParseSrcset  1000000        1390 ns/op       272 B/op        6 allocs/op
+func BenchmarkParseSrcset(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseSrcset("small.jpg 480w, medium.jpg 800w, large.jpg 1080w")
+	}
+}
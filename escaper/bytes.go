@@ -0,0 +1,180 @@
+package escaper
+
+import (
+	"unsafe"
+)
+
+// The functions in this file are the []byte versions of the escaper functions.
+// They share their implementation with the string versions, so the two versions
+// always agree.
+//
+// Like the string versions, they return the argument itself when there is
+// nothing to change. Otherwise, the result is a new slice, and the argument is
+// left alone.
+
+// bytesToString returns a string that shares its memory with b, so b must not
+// be modified while the string is in use. It is used to view arguments that are
+// not kept past the end of the call, and to return freshly escaped buffers that
+// nothing else refers to without copying them.
+//
+func bytesToString(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
+
+// EscapeAttributeValueDoubleQuotedBytes is like
+// EscapeAttributeValueDoubleQuoted, for a []byte.
+//
+func EscapeAttributeValueDoubleQuotedBytes(val []byte) []byte {
+	if b := escapeAttributeValue(bytesToString(val), doubleQuotedReplacements); b != nil {
+		return b
+	}
+	return val
+}
+
+// EscapeAttributeValueSingleQuotedBytes is like
+// EscapeAttributeValueSingleQuoted, for a []byte.
+//
+func EscapeAttributeValueSingleQuotedBytes(val []byte) []byte {
+	if b := escapeAttributeValue(bytesToString(val), singleQuotedReplacements); b != nil {
+		return b
+	}
+	return val
+}
+
+// EscapeAttributeValueUnquotedBytes is like EscapeAttributeValueUnquoted, for
+// a []byte.
+//
+func EscapeAttributeValueUnquotedBytes(val []byte) []byte {
+	if b := escapeAttributeValue(bytesToString(val), unquotedReplacements); b != nil {
+		return b
+	}
+	return val
+}
+
+// EscapeAmbiguousAmpersandsBytes is like EscapeAmbiguousAmpersands, for a
+// []byte.
+//
+func EscapeAmbiguousAmpersandsBytes(val []byte) []byte {
+	if b := escapeAmbiguousAmpersandsBuffer(bytesToString(val)); b != nil {
+		return b
+	}
+	return val
+}
+
+// EscapeTextBytes is like EscapeText, for a []byte.
+//
+func EscapeTextBytes(s []byte) []byte {
+	if b := escapeText(bytesToString(s), false); b != nil {
+		return b
+	}
+	return s
+}
+
+// EscapeTextStrictBytes is like EscapeTextStrict, for a []byte.
+//
+func EscapeTextStrictBytes(s []byte) []byte {
+	if b := escapeText(bytesToString(s), true); b != nil {
+		return b
+	}
+	return s
+}
+
+// EscapeEscapableRawTextBytes is like EscapeEscapableRawText, for a []byte.
+//
+func EscapeEscapableRawTextBytes(tag string, text []byte) []byte {
+	if b := escapeEscapableRawText(tag, false, bytesToString(text)); b != nil {
+		return b
+	}
+	return text
+}
+
+// EscapeScriptContentBytes is like EscapeScriptContent, for a []byte.
+//
+func EscapeScriptContentBytes(s []byte) []byte {
+	if b := escapeScriptContent(bytesToString(s)); b != nil {
+		return b
+	}
+	return s
+}
+
+// EscapeStyleContentBytes is like EscapeStyleContent, for a []byte.
+//
+func EscapeStyleContentBytes(s []byte) []byte {
+	if b := escapeStyleContent(bytesToString(s)); b != nil {
+		return b
+	}
+	return s
+}
+
+// EscapeCommentTextBytes is like EscapeCommentText, for a []byte.
+//
+func EscapeCommentTextBytes(text []byte) []byte {
+	if b := escapeCommentText(bytesToString(text)); b != nil {
+		return b
+	}
+	return text
+}
+
+// UnescapeBytes is like Unescape, for a []byte.
+//
+func UnescapeBytes(s []byte) []byte {
+	if b := unescape(bytesToString(s), false); b != nil {
+		return b
+	}
+	return s
+}
+
+// UnescapeAttributeValueBytes is like UnescapeAttributeValue, for a []byte.
+//
+func UnescapeAttributeValueBytes(val []byte) []byte {
+	if b := unescape(bytesToString(val), true); b != nil {
+		return b
+	}
+	return val
+}
+
+// EscapeURLAttributeBytes is like EscapeURLAttribute, for a []byte.
+//
+func EscapeURLAttributeBytes(val []byte, policy URLPolicy) []byte {
+	if b := escapeURLAttribute(bytesToString(val), policy); b != nil {
+		return b
+	}
+	return val
+}
+
+// EscapeCSSIdentifierBytes is like EscapeCSSIdentifier, for a []byte.
+//
+func EscapeCSSIdentifierBytes(s []byte) []byte {
+	if b := escapeCSSIdentifier(bytesToString(s), false); b != nil {
+		return b
+	}
+	return s
+}
+
+// EscapeCSSStringBytes is like EscapeCSSString, for a []byte.
+//
+func EscapeCSSStringBytes(s []byte) []byte {
+	if b := escapeCSSString(bytesToString(s)); b != nil {
+		return b
+	}
+	return s
+}
+
+// EscapeJSStringBytes is like EscapeJSString, for a []byte.
+//
+func EscapeJSStringBytes(s []byte) []byte {
+	if b := escapeJSString(bytesToString(s)); b != nil {
+		return b
+	}
+	return s
+}
+
+// EscapeJSStringInAttributeBytes is like EscapeJSStringInAttribute, for a
+// []byte.
+//
+func EscapeJSStringInAttributeBytes(val []byte, quote byte) []byte {
+	if b := escapeJSStringInAttribute(bytesToString(val), attributeReplacements(quote)); b != nil {
+		return b
+	}
+	return val
+}
@@ -0,0 +1,135 @@
+package escaper
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// bytesTestInputs are run through both the string and the []byte version of
+// each function, which must agree.
+var bytesTestInputs = []string{
+	"",
+	"a",
+	"nothing to be escaped",
+	`My name is "Franklin".`,
+	`An "&ambiguous;" ampersand.`,
+	"&amp;",
+	"&amp",
+	"&;",
+	"&copy 2014",
+	"&copy; 2014",
+	"&notit;",
+	"&notin;",
+	"?a=1&copy=2",
+	"&#65;&#x2665;&#0;&#x80;",
+	"&#169 2014 &#x41",
+	"&nLt;",
+	"this &⌘&that;.",
+	"test &a;&b;&c;&d;&e;&f;&g;&h; ⌘",
+	"\n</textarea>",
+	"<!--<script>--></Script></STYLE",
+	"1abc",
+	"-",
+	"--x",
+	"a.b#c",
+	"->a --!> <!-->",
+}
+
+func TestBytesVersionsAgree(t *testing.T) {
+
+	fns := map[string][2]func(string) string{
+		"EscapeAttributeValueDoubleQuoted": {EscapeAttributeValueDoubleQuoted, bytesFunc(EscapeAttributeValueDoubleQuotedBytes)},
+		"EscapeAttributeValueSingleQuoted": {EscapeAttributeValueSingleQuoted, bytesFunc(EscapeAttributeValueSingleQuotedBytes)},
+		"EscapeAttributeValueUnquoted":     {EscapeAttributeValueUnquoted, bytesFunc(EscapeAttributeValueUnquotedBytes)},
+		"EscapeAmbiguousAmpersands":        {EscapeAmbiguousAmpersands, bytesFunc(EscapeAmbiguousAmpersandsBytes)},
+		"EscapeText":                       {EscapeText, bytesFunc(EscapeTextBytes)},
+		"EscapeTextStrict":                 {EscapeTextStrict, bytesFunc(EscapeTextStrictBytes)},
+		"EscapeScriptContent":              {EscapeScriptContent, bytesFunc(EscapeScriptContentBytes)},
+		"EscapeStyleContent":               {EscapeStyleContent, bytesFunc(EscapeStyleContentBytes)},
+		"EscapeCommentText":                {EscapeCommentText, bytesFunc(EscapeCommentTextBytes)},
+		"EscapeCSSIdentifier":              {EscapeCSSIdentifier, bytesFunc(EscapeCSSIdentifierBytes)},
+		"EscapeCSSString":                  {EscapeCSSString, bytesFunc(EscapeCSSStringBytes)},
+		"EscapeJSString":                   {EscapeJSString, bytesFunc(EscapeJSStringBytes)},
+		"Unescape":                         {Unescape, bytesFunc(UnescapeBytes)},
+		"UnescapeAttributeValue":           {UnescapeAttributeValue, bytesFunc(UnescapeAttributeValueBytes)},
+		"EscapeEscapableRawText": {
+			func(s string) string { return EscapeEscapableRawText("textarea", s) },
+			bytesFunc(func(b []byte) []byte { return EscapeEscapableRawTextBytes("textarea", b) }),
+		},
+		"EscapeJSStringInAttribute": {
+			func(s string) string { return EscapeJSStringInAttribute(s, '"') },
+			bytesFunc(func(b []byte) []byte { return EscapeJSStringInAttributeBytes(b, '"') }),
+		},
+		"EscapeURLAttribute": {
+			func(s string) string { return EscapeURLAttribute(s, DefaultURLPolicy) },
+			bytesFunc(func(b []byte) []byte { return EscapeURLAttributeBytes(b, DefaultURLPolicy) }),
+		},
+	}
+
+	for label, fn := range fns {
+		for _, input := range bytesTestInputs {
+			expected, actual := fn[0](input), fn[1](input)
+			if expected != actual {
+				t.Errorf("%s(%q) is %q, but %sBytes is %q.", label, input, expected, label, actual)
+			}
+		}
+	}
+}
+
+func TestBytesVersionsDontModifyArgument(t *testing.T) {
+
+	fns := []func([]byte) []byte{
+		EscapeAttributeValueDoubleQuotedBytes,
+		EscapeAttributeValueSingleQuotedBytes,
+		EscapeAttributeValueUnquotedBytes,
+		EscapeAmbiguousAmpersandsBytes,
+		EscapeTextBytes,
+		EscapeTextStrictBytes,
+		EscapeScriptContentBytes,
+		EscapeStyleContentBytes,
+		EscapeCommentTextBytes,
+		EscapeCSSIdentifierBytes,
+		EscapeCSSStringBytes,
+		EscapeJSStringBytes,
+		func(b []byte) []byte { return EscapeEscapableRawTextBytes("textarea", b) },
+		func(b []byte) []byte { return EscapeURLAttributeBytes(b, DefaultURLPolicy) },
+		func(b []byte) []byte { return EscapeJSStringInAttributeBytes(b, '"') },
+		UnescapeBytes,
+		UnescapeAttributeValueBytes,
+	}
+
+	for _, fn := range fns {
+		for _, input := range bytesTestInputs {
+			b := []byte(input)
+			fn(b)
+			if !bytes.Equal(b, []byte(input)) {
+				t.Errorf("Argument %q was modified to %q.", input, b)
+			}
+		}
+	}
+}
+
+// bytesFunc adapts a []byte function so it can be compared with its string
+// version.
+func bytesFunc(fn func([]byte) []byte) func(string) string {
+	return func(s string) string {
+		return string(fn([]byte(s)))
+	}
+}
+
+func ExampleEscapeAttributeValueDoubleQuotedBytes() {
+	title := EscapeAttributeValueDoubleQuotedBytes([]byte(`My name is "Franklin".`))
+	fmt.Printf("title=%q", title)
+	// Output:
+	// title="My name is &#34;Franklin&#34;."
+}
+
+// BenchmarkEscapeAttributeValueDoubleQuotedBytes_none  50000000          18.6 ns/op         0 B/op        0 allocs/op
+func BenchmarkEscapeAttributeValueDoubleQuotedBytes_none(b *testing.B) {
+	val := []byte("nothing to be escaped")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = EscapeAttributeValueDoubleQuotedBytes(val)
+	}
+}
@@ -0,0 +1,51 @@
+package escaper
+
+import (
+	"strings"
+)
+
+const (
+	cdataStart = "<![CDATA["
+	cdataEnd   = "]]>"
+)
+
+// WrapCDATA returns the argument wrapped in a CDATA section, for use in foreign
+// content like inline SVG and MathML. Nothing in a CDATA section is decoded or
+// parsed as markup, so "<" and "&" can be used as-is.
+//
+// A CDATA section can't contain "]]>", so the argument is split across as many
+// sections as it takes: each "]]>" ends one section after the "]]", and the
+// ">" starts the next. For example, "a]]>b" becomes
+// "<![CDATA[a]]]]><![CDATA[>b]]>", which a parser reads back as "a]]>b".
+//
+// See checker.IsValidCDATAContent.
+//
+func WrapCDATA(s string) string {
+	b := AppendCDATA(make([]byte, 0, len(s)+len(cdataStart)+len(cdataEnd)), s)
+	return bytesToString(b)
+}
+
+// AppendCDATA appends the argument, wrapped as by WrapCDATA, to dst and returns
+// the extended buffer. It doesn't allocate if dst has enough capacity.
+//
+func AppendCDATA(dst []byte, s string) []byte {
+
+	dst = append(dst, cdataStart...)
+	dst = appendCDATAContent(dst, s)
+	return append(dst, cdataEnd...)
+}
+
+// appendCDATAContent appends the argument to dst, with each "]]>" split across
+// two CDATA sections, as WrapCDATA does, but without the enclosing section.
+//
+func appendCDATAContent(dst []byte, s string) []byte {
+	for {
+		i := strings.Index(s, cdataEnd)
+		if i == -1 {
+			return append(dst, s...)
+		}
+		dst = append(dst, s[:i+len("]]")]...)
+		dst = append(dst, cdataEnd+cdataStart...)
+		s = s[i+len("]]"):]
+	}
+}
@@ -0,0 +1,60 @@
+package escaper
+
+import (
+	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
+	"strings"
+	"testing"
+)
+
+func TestWrapCDATA(t *testing.T) {
+
+	cases := map[string]string{
+		"":               "<![CDATA[]]>",
+		"x < y && y > z": "<![CDATA[x < y && y > z]]>",
+		"a[b[0]]":        "<![CDATA[a[b[0]]]]>",
+		"a]]>b":          "<![CDATA[a]]]]><![CDATA[>b]]>",
+		"]]>]]>":         "<![CDATA[]]]]><![CDATA[>]]]]><![CDATA[>]]>",
+		"]]]>":           "<![CDATA[]]]]]><![CDATA[>]]>",
+	}
+
+	checkTestCases(t, cases, WrapCDATA, "WrapCDATA")
+	checkAppendAgrees(t, writerTestInputs, AppendCDATA, WrapCDATA, "AppendCDATA")
+}
+
+func TestWrapCDATA_roundTrip(t *testing.T) {
+	inputs := append([]string{"]]>", "]]]]>>", "a]]>b]]>c", "<![CDATA[x]]>"}, writerTestInputs...)
+	for _, input := range inputs {
+		output := WrapCDATA(input)
+		if decoded := readCDATASections(t, output); decoded != input {
+			t.Errorf("WrapCDATA(%q) is %q, which reads back as %q.", input, output, decoded)
+		}
+	}
+}
+
+// readCDATASections reads back the content of the consecutive CDATA sections
+// in s, checking that each one is valid.
+func readCDATASections(t *testing.T, s string) string {
+	var content string
+	for s != "" {
+		if !strings.HasPrefix(s, "<![CDATA[") {
+			t.Fatalf("Expected a CDATA section at %q.", s)
+		}
+		s = s[len("<![CDATA["):]
+		end := strings.Index(s, "]]>")
+		if end == -1 || !checker.IsValidCDATAContent(s[:end]) {
+			t.Fatalf("Expected the end of the CDATA section in %q.", s)
+		}
+		content += s[:end]
+		s = s[end+len("]]>"):]
+	}
+	return content
+}
+
+func ExampleWrapCDATA() {
+	fmt.Printf("<style>%s</style>\n", WrapCDATA("a[href]>b { color: red }"))
+	fmt.Println(WrapCDATA("x]]>y"))
+	// Output:
+	// <style><![CDATA[a[href]>b { color: red }]]></style>
+	// <![CDATA[x]]]]><![CDATA[>y]]>
+}
@@ -0,0 +1,85 @@
+package escaper
+
+import (
+	"strings"
+)
+
+// EscapeCommentText returns the argument with the smallest changes that make
+// it valid comment text, i.e. safe to put between "<!--" and "-->". There are
+// no character references in comments, so a space is inserted to break up
+// each sequence that isn't allowed:
+//
+//     ">" or "->" at the start   a space is added at the start
+//     "<!--", "-->", or "--!>"   "--" becomes "- -"
+//     "<!-" at the end           a space is added at the end
+//
+// See checker.IsValidCommentText.
+//
+func EscapeCommentText(text string) string {
+	if b := escapeCommentText(text); b != nil {
+		return bytesToString(b)
+	}
+	return text
+}
+
+// AppendCommentText appends the argument, escaped as by EscapeCommentText, to
+// dst and returns the extended buffer. It doesn't allocate if dst has enough
+// capacity.
+//
+func AppendCommentText(dst []byte, text string) []byte {
+
+	if strings.HasPrefix(text, ">") || strings.HasPrefix(text, "->") {
+		dst = append(dst, ' ')
+	}
+
+	for i := 0; ; {
+		j := indexUnsafeCommentDashes(text, i)
+		if j == -1 {
+			dst = append(dst, text[i:]...)
+			break
+		}
+
+		// Copy up to and including the first dash, then break the pair.
+
+		dst = append(dst, text[i:j+1]...)
+		dst = append(dst, ' ')
+		i = j + 1
+	}
+
+	if strings.HasSuffix(text, "<!-") {
+		dst = append(dst, ' ')
+	}
+	return dst
+}
+
+// escapeCommentText implements EscapeCommentText and its []byte version. It
+// returns nil if nothing needs to be escaped.
+//
+func escapeCommentText(text string) []byte {
+
+	if !strings.HasPrefix(text, ">") && !strings.HasPrefix(text, "->") &&
+		indexUnsafeCommentDashes(text, 0) == -1 && !strings.HasSuffix(text, "<!-") {
+		return nil
+	}
+
+	return AppendCommentText(make([]byte, 0, len(text)+4), text)
+}
+
+// indexUnsafeCommentDashes returns the index of the first "--", at or after
+// from, that is part of a "<!--", "-->", or "--!>", or -1 if there isn't one.
+//
+func indexUnsafeCommentDashes(text string, from int) int {
+	for i := from; ; {
+		j := strings.Index(text[i:], "--")
+		if j == -1 {
+			return -1
+		}
+		i += j
+		rest := text[i+2:]
+		if strings.HasSuffix(text[:i], "<!") || strings.HasPrefix(rest, ">") ||
+			strings.HasPrefix(rest, "!>") {
+			return i
+		}
+		i++
+	}
+}
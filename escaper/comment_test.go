@@ -0,0 +1,48 @@
+package escaper
+
+import (
+	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
+	"testing"
+)
+
+func TestEscapeCommentText(t *testing.T) {
+
+	cases := map[string]string{
+		"":                   "",
+		" a comment ":        " a comment ",
+		"run with --verbose": "run with --verbose",
+		">":                  " >",
+		"->":                 " ->",
+		"-->":                "- ->",
+		"a --> b":            "a - -> b",
+		"a <!-- b":           "a <!- - b",
+		"a --!> b":           "a - -!> b",
+		"a <!-":              "a <!- ",
+		"<!-->":              "<!- ->",
+		"--->":               "-- ->",
+		"a <!--> b":          "a <!- -> b",
+		"fix the --> footer": "fix the - -> footer",
+	}
+
+	checkTestCases(t, cases, EscapeCommentText, "EscapeCommentText")
+	checkAppendAgrees(t, writerTestInputs, AppendCommentText, EscapeCommentText,
+		"AppendCommentText")
+}
+
+func TestEscapeCommentText_valid(t *testing.T) {
+	inputs := append([]string{
+		"<!---->", "<!--!>", "-->-->", "--!>--!>", "<!-<!--", "->-->", "<!<!--->",
+	}, writerTestInputs...)
+	for _, input := range inputs {
+		if output := EscapeCommentText(input); !checker.IsValidCommentText(output) {
+			t.Errorf("EscapeCommentText(%q) is %q, which is not valid.", input, output)
+		}
+	}
+}
+
+func ExampleEscapeCommentText() {
+	fmt.Printf("<!--%s-->\n", EscapeCommentText(" Fix the --> in the footer "))
+	// Output:
+	// <!-- Fix the - -> in the footer -->
+}
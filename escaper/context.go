@@ -0,0 +1,111 @@
+package escaper
+
+import (
+	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
+	"strings"
+)
+
+// Context is a place in an HTML document where text can go. It is the same
+// type as checker.Context, so a context can be passed to both Escape and
+// checker.IsValid.
+//
+type Context = checker.Context
+
+// The contexts. See checker.Context.
+const (
+	Text         = checker.Text
+	RCDATA       = checker.RCDATA
+	RawText      = checker.RawText
+	Comment      = checker.Comment
+	AttrUnquoted = checker.AttrUnquoted
+	AttrSingle   = checker.AttrSingle
+	AttrDouble   = checker.AttrDouble
+	URL          = checker.URL
+	CSSString    = checker.CSSString
+	JSString     = checker.JSString
+	CDATA        = checker.CDATA
+)
+
+// Escape returns the argument escaped for use in the given context, with the
+// package function for that context:
+//
+//     Text          EscapeText
+//     RCDATA        EscapeEscapableRawText, for any end tag
+//     RawText       EscapeScriptContent
+//     Comment       EscapeCommentText
+//     AttrUnquoted  EscapeAttributeValueUnquoted
+//     AttrSingle    EscapeAttributeValueSingleQuoted
+//     AttrDouble    EscapeAttributeValueDoubleQuoted
+//     URL           EscapeURLAttribute, with DefaultURLPolicy
+//     CSSString     EscapeCSSString
+//     JSString      EscapeJSString
+//     CDATA         the inside of WrapCDATA, without the enclosing section
+//
+// The result is valid according to checker.IsValid for the same context,
+// except that an empty unquoted attribute value can't be made valid. For
+// RCDATA, the "<" of every "</" is escaped, so the result is valid in both
+// <textarea> and <title>, and a newline at the start is doubled, so that a
+// <textarea> doesn't drop it.
+//
+// It panics if the context is unknown.
+//
+func Escape(ctx Context, s string) string {
+
+	switch ctx {
+	case Text:
+		return EscapeText(s)
+	case RCDATA:
+		return escapeRCDATA(s)
+	case RawText:
+		return EscapeScriptContent(s)
+	case Comment:
+		return EscapeCommentText(s)
+	case AttrUnquoted:
+		return EscapeAttributeValueUnquoted(s)
+	case AttrSingle:
+		return EscapeAttributeValueSingleQuoted(s)
+	case AttrDouble:
+		return EscapeAttributeValueDoubleQuoted(s)
+	case URL:
+		return EscapeURLAttribute(s, DefaultURLPolicy)
+	case CSSString:
+		return EscapeCSSString(s)
+	case JSString:
+		return EscapeJSString(s)
+	case CDATA:
+		return escapeCDATAContent(s)
+	}
+	panic(fmt.Sprintf("escaper: unknown context %v", ctx))
+}
+
+// escapeCDATAContent implements Escape for CDATA.
+//
+func escapeCDATAContent(s string) string {
+	if strings.Index(s, cdataEnd) == -1 {
+		return s
+	}
+	return bytesToString(appendCDATAContent(make([]byte, 0, len(s)+16), s))
+}
+
+// Escape is like the package function Escape, with the options of the
+// Escaper applied in the contexts that have character references.
+//
+func (e Escaper) Escape(ctx Context, s string) string {
+
+	switch ctx {
+	case Text:
+		return e.EscapeText(s)
+	case RCDATA:
+		return e.escapeEscapableRawText("", true, s)
+	case AttrUnquoted:
+		return e.EscapeAttributeValueUnquoted(s)
+	case AttrSingle:
+		return e.EscapeAttributeValueSingleQuoted(s)
+	case AttrDouble:
+		return e.EscapeAttributeValueDoubleQuoted(s)
+	case URL:
+		return e.EscapeURLAttribute(s, DefaultURLPolicy)
+	}
+	return Escape(ctx, s)
+}
@@ -0,0 +1,152 @@
+package escaper
+
+import (
+	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
+	"testing"
+)
+
+var allContexts = []Context{
+	Text, RCDATA, RawText, Comment, AttrUnquoted, AttrSingle, AttrDouble, URL, CSSString, JSString, CDATA,
+}
+
+func TestEscape_valid(t *testing.T) {
+	inputs := append([]string{
+		"</textarea>", "</title>", "</script>", "</style>", "<!--", "-->", "]]>",
+		"javascript:alert(1)", "/a?b=1&copy=2", `\`, "\x00\x1f\x7f", "a\u2028b",
+	}, writerTestInputs...)
+
+	for _, ctx := range allContexts {
+		for _, input := range inputs {
+			if ctx == AttrUnquoted && input == "" {
+				continue // An empty unquoted value can't be made valid.
+			}
+			if output := Escape(ctx, input); !checker.IsValid(ctx, output) {
+				t.Errorf("Escape(%v, %q) is %q, which is not valid.", ctx, input, output)
+			}
+		}
+	}
+}
+
+func TestEscape(t *testing.T) {
+
+	cases := map[Context][2]string{
+		Text:         {"a < b & c", "a &lt; b & c"},
+		RCDATA:       {"</textarea></title>", "&lt;/textarea>&lt;/title>"},
+		RawText:      {"</script>", `<\/script>`},
+		Comment:      {"a --> b", "a - -> b"},
+		AttrUnquoted: {"a b", "a&#32;b"},
+		AttrSingle:   {"it's", "it&#39;s"},
+		AttrDouble:   {`"a"`, "&#34;a&#34;"},
+		URL:          {"javascript:alert(1)", InvalidURL},
+		CSSString:    {`"a"`, `\22 a\22 `},
+		JSString:     {`"a"`, `\"a\"`},
+		CDATA:        {"a]]>b", "a]]]]><![CDATA[>b"},
+	}
+
+	for ctx, c := range cases {
+		if actual := Escape(ctx, c[0]); actual != c[1] {
+			t.Errorf("Escape(%v, %q) is %q, but expected %q.", ctx, c[0], actual, c[1])
+		}
+	}
+}
+
+func TestEscape_rcdataLeadingNewline(t *testing.T) {
+
+	// A <textarea> drops a newline at the start of its text, so RCDATA keeps
+	// it with another one, for any tag.
+
+	cases := map[string]string{
+		"\nhello":   "\n\nhello",
+		"\r\nhello": "\n\r\nhello",
+		"a\nb":      "a\nb",
+	}
+	checkTestCases(t, cases, func(s string) string { return Escape(RCDATA, s) }, "Escape(RCDATA)")
+
+	for _, e := range []Escaper{{}, {AllAmpersands: true}, {References: HexReferences}} {
+		for input, expected := range cases {
+			if actual := e.Escape(RCDATA, input); actual != expected {
+				t.Errorf("%+v.Escape(RCDATA, %q) is %q, but expected %q.", e, input, actual, expected)
+			}
+		}
+	}
+}
+
+func TestEscape_unknownContext(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected Escape to panic for an unknown context.")
+		}
+	}()
+	Escape(CDATA+1, "")
+}
+
+func TestEscaper_Escape(t *testing.T) {
+
+	var e Escaper
+	for _, ctx := range allContexts {
+		for _, input := range writerTestInputs {
+			if actual, expected := e.Escape(ctx, input), Escape(ctx, input); actual != expected {
+				t.Errorf("Escaper{}.Escape(%v, %q) is %q, but Escape gives %q.", ctx, input, actual, expected)
+			}
+		}
+	}
+
+	hex := Escaper{References: HexReferences}
+	if actual := hex.Escape(AttrDouble, `"a"`); actual != "&#x22;a&#x22;" {
+		t.Errorf("Escaper{References: HexReferences}.Escape(AttrDouble, %q) is %q.", `"a"`, actual)
+	}
+}
+
+func TestEscapeCSSString(t *testing.T) {
+	cases := map[string]string{
+		"":           "",
+		"plain text": "plain text",
+		`say "hi"`:   `say \22 hi\22 `,
+		"it's":       `it\27 s`,
+		`a\b`:        `a\\b`,
+		"a\nb":       `a\a b`,
+		"\x00":       `\fffd `,
+		"\x7f":       `\7f `,
+		"</style>":   `\3c /style\3e `,
+		"AT&T":       `AT\26 T`,
+		"café":       "café",
+		"\x1f1":      `\1f 1`,
+	}
+	checkTestCases(t, cases, EscapeCSSString, "EscapeCSSString")
+}
+
+func TestAppendCSSString(t *testing.T) {
+	checkAppendAgrees(t, writerTestInputs, AppendCSSString, EscapeCSSString, "AppendCSSString")
+}
+
+func TestEscapeJSString(t *testing.T) {
+	cases := map[string]string{
+		"":           "",
+		"plain text": "plain text",
+		`say "hi"`:   `say \"hi\"`,
+		"it's":       `it\'s`,
+		`a\b`:        `a\\b`,
+		"a\nb\r":     `a\nb\r`,
+		"</script>":  `\x3C/script>`,
+		"AT&T":       `AT\x26T`,
+		"a\u2028b":   `a\u2028b`,
+		"\x00":       `\x00`,
+	}
+	checkTestCases(t, cases, EscapeJSString, "EscapeJSString")
+}
+
+func TestAppendJSString(t *testing.T) {
+	checkAppendAgrees(t, writerTestInputs, AppendJSString, EscapeJSString, "AppendJSString")
+}
+
+func ExampleEscape() {
+	name := `O'Brien & "Sons" </div>`
+	fmt.Println(Escape(Text, name))
+	fmt.Println(Escape(AttrSingle, name))
+	fmt.Println(Escape(JSString, name))
+	// Output:
+	// O'Brien & "Sons" &lt;/div>
+	// O&#39;Brien & "Sons" </div>
+	// O\'Brien \x26 \"Sons\" \x3C/div>
+}
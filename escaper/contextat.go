@@ -0,0 +1,1137 @@
+package escaper
+
+import (
+	"github.com/Dancapistan/htmlutil/checker"
+	"strconv"
+	"strings"
+)
+
+// ContextProblem is the reason ContextAt can't give a context for a prefix.
+// The String method returns a short name for it, like "in-tag".
+//
+type ContextProblem int
+
+const (
+	// InTag means the prefix ends inside a tag, but not in an attribute value,
+	// e.g. "<a hr".
+	InTag ContextProblem = iota
+
+	// InMarkupDeclaration means the prefix ends in a doctype or a bogus
+	// comment, e.g. "<!DOCTYPE ht" or "<?xml".
+	InMarkupDeclaration
+
+	// InPlaintext means the prefix ends after a <plaintext> start tag, which
+	// nothing ends.
+	InPlaintext
+
+	// InRawTextElement means the prefix ends in a raw text element other than
+	// <script>, like <style> outside of a string, or <iframe>. Use
+	// EscapeStyleContent for the whole content of a <style>.
+	InRawTextElement
+
+	// InScriptToken means the prefix ends in a <script> inside a comment, a
+	// template literal, or a regular expression literal.
+	InScriptToken
+
+	// InScriptAttribute means the prefix ends in the value of an event handler
+	// attribute, like onclick. Use EscapeJSStringInAttribute for a string
+	// literal in it.
+	InScriptAttribute
+
+	// InStyleAttribute means the prefix ends in the value of a style
+	// attribute.
+	InStyleAttribute
+
+	// InSrcsetAttribute means the prefix ends in the value of a srcset or
+	// imagesrcset attribute. Use EscapeSrcset for the whole value.
+	InSrcsetAttribute
+
+	// InURLScheme means the prefix ends in a URL attribute value whose scheme
+	// isn't known to be safe yet, e.g. `<a href="java`, or in an unquoted URL
+	// attribute value that is still empty.
+	InURLScheme
+
+	// SplitSequence means the prefix ends partway through something that the
+	// next bytes could finish and change the context with, e.g. "</scr" in a
+	// script, or a backslash in a JS string.
+	SplitSequence
+
+	// UnclearForeignContent means the prefix has a tag in or under <svg> or
+	// <math> whose effect on foreign content depends on elements that
+	// ContextAt doesn't keep track of, e.g. the "</div>" in
+	// "<div><svg></div>", which closes the <svg>, or a table tag in a
+	// <foreignObject>.
+	UnclearForeignContent
+
+	// InSVGScriptOrStyle means the prefix ends in the text of a <script> or
+	// <style> element of <svg>. The text is read like that of any other
+	// element, with tags and character references, but it still runs as a
+	// script, or applies as a stylesheet.
+	InSVGScriptOrStyle
+
+	// InSrcdocAttribute means the prefix ends in the value of a srcdoc
+	// attribute, which is an HTML document of its own. Use ContextAt and
+	// Escape on that document, and then escape all of it for the attribute.
+	InSrcdocAttribute
+
+	// InAnimationAttribute means the prefix ends in the value of a to, from,
+	// by, or values attribute of an SVG animation element, like <set>, which
+	// can set another attribute, like an href, to the value.
+	InAnimationAttribute
+
+	// InRefreshAttribute means the prefix ends in the content attribute of a
+	// <meta> that is, or may be, a refresh, e.g. `<meta http-equiv=refresh
+	// content="`. The content can have a URL to go to.
+	InRefreshAttribute
+)
+
+var contextProblemNames = [...]string{
+	InTag:                 "in-tag",
+	InMarkupDeclaration:   "in-markup-declaration",
+	InPlaintext:           "in-plaintext",
+	InRawTextElement:      "in-raw-text-element",
+	InScriptToken:         "in-script-token",
+	InScriptAttribute:     "in-script-attribute",
+	InStyleAttribute:      "in-style-attribute",
+	InSrcsetAttribute:     "in-srcset-attribute",
+	InURLScheme:           "in-url-scheme",
+	SplitSequence:         "split-sequence",
+	UnclearForeignContent: "unclear-foreign-content",
+	InSVGScriptOrStyle:    "in-svg-script-or-style",
+	InSrcdocAttribute:     "in-srcdoc-attribute",
+	InAnimationAttribute:  "in-animation-attribute",
+	InRefreshAttribute:    "in-refresh-attribute",
+}
+
+func (p ContextProblem) String() string {
+	if p < 0 || int(p) >= len(contextProblemNames) {
+		return "unknown-context-problem"
+	}
+	return contextProblemNames[p]
+}
+
+// ContextError is the error ContextAt returns when it can't give a context for
+// a prefix. Text is the end of the prefix, from the start of the part the
+// problem is in, e.g. the "<" of the tag for InTag.
+//
+type ContextError struct {
+	Problem ContextProblem
+	Text    string
+}
+
+func (e *ContextError) Error() string {
+	return "escaper: " + e.Problem.String() + " in " + strconv.Quote(e.Text)
+}
+
+// NoContext is the Context ContextAt returns with an error. It isn't one of the
+// contexts, so Escape panics for it, and checker.IsValid returns false.
+//
+const NoContext Context = -1
+
+// ContextAt returns the context that the next bytes after the prefix of an
+// HTML document land in, so that untrusted text can be escaped for it with
+// Escape, and appended to the prefix. For example, the prefix "<p>Hello, "
+// gives Text, and `<input value="` gives AttrDouble.
+//
+// The prefix is read with the state machine of the HTML tokenizer, starting in
+// text content. The element the prefix ends in decides the context:
+//
+//     Text          normal elements, and elements in <svg> and <math>,
+//                   other than an SVG <script> or <style>
+//     RCDATA        <textarea> and <title>
+//     RawText       <script>, outside of string literals
+//     JSString      a string literal in a <script>
+//     CSSString     a string literal in a <style>
+//     Comment       a comment
+//     CDATA         a CDATA section in <svg> or <math>
+//
+// In a tag, the attribute the prefix ends in decides the context:
+//
+//     AttrDouble    a value in double quotes
+//     AttrSingle    a value in single quotes
+//     AttrUnquoted  an unquoted value, or the start of a value after "="
+//     URL           the empty start of a quoted URL value, like href="
+//
+// After the start of a URL value, once its scheme is known to be safe, or it
+// has a "/", "?", or "#" before any ":", the context is the one for a value in
+// the same quotes, because the next bytes can't change the scheme any more.
+//
+// Anywhere else, and wherever none of the contexts would keep untrusted text
+// from changing the meaning of the document, it returns NoContext and a
+// *ContextError.
+//
+// The tokenizer doesn't build a tree, so it keeps track of foreign content
+// with the elements from the outermost <svg> or <math> in. It knows about the
+// integration points, like <foreignObject>, where the content is HTML again,
+// and the tags that end foreign content, like <p>. For a tag whose effect
+// depends on the elements outside of those, like an end tag that doesn't
+// close the current element, it gives UnclearForeignContent. The scripting
+// flag is taken to be on, so <noscript> is a raw text element.
+//
+func ContextAt(prefix string) (Context, error) {
+
+	c := contextScanner{s: prefix}
+	for i := 0; i < len(prefix); {
+
+		j := strings.IndexByte(prefix[i:], '<')
+		if j == -1 {
+			break
+		}
+		i += j
+
+		var done bool
+		if i, done = c.markup(i); done {
+			return c.ctx, c.err
+		}
+	}
+	c.result(Text)
+	return c.ctx, c.err
+}
+
+// contextScanner implements ContextAt. Its methods return the index in s to
+// continue reading text at, or done and the result of ContextAt if s ends
+// first.
+//
+type contextScanner struct {
+	s    string
+	open []openElement // The open elements, from the outermost <svg> or <math>.
+
+	ctx Context
+	err error
+}
+
+// result sets the result of ContextAt to ctx, and returns done.
+//
+func (c *contextScanner) result(ctx Context) (int, bool) {
+
+	// The text of an SVG <script> or <style> is code, though it is read like
+	// the text of any other element.
+
+	if e := c.current(); e != nil && e.ns == "svg" && (ctx == Text || ctx == CDATA) &&
+		(e.name == "script" || e.name == "style") {
+		return c.fail(InSVGScriptOrStyle, e.content)
+	}
+	c.ctx = ctx
+	return 0, true
+}
+
+// fail sets the result of ContextAt to a ContextError for the problem, with the
+// text from start, and returns done.
+//
+func (c *contextScanner) fail(problem ContextProblem, start int) (int, bool) {
+	c.ctx = NoContext
+	c.err = &ContextError{Problem: problem, Text: c.s[start:]}
+	return 0, true
+}
+
+// markup reads what starts with the "<" at index i.
+//
+func (c *contextScanner) markup(i int) (int, bool) {
+
+	rest := c.s[i+1:]
+	switch {
+	case rest == "":
+		return c.fail(SplitSequence, i)
+	case isASCIIAlpha(rest[0]):
+		return c.tag(i, i+1, false)
+	case rest[0] == '/':
+		switch {
+		case len(rest) == 1:
+			return c.fail(SplitSequence, i)
+		case isASCIIAlpha(rest[1]):
+			return c.tag(i, i+2, true)
+		case rest[1] == '>':
+			return i + len("</>"), false
+		}
+		return c.bogusComment(i, i+2)
+	case rest[0] == '?':
+		return c.bogusComment(i, i+1)
+	case rest[0] == '!':
+		return c.markupDeclaration(i)
+	}
+	return i + 1, false // Just a "<".
+}
+
+// markupDeclaration reads what starts with the "<!" at index i.
+//
+func (c *contextScanner) markupDeclaration(i int) (int, bool) {
+
+	rest := c.s[i+len("<!"):]
+	e := c.current()
+	cdata := e != nil && e.kind != htmlElement
+
+	switch {
+	case e != nil && e.kind == htmlElement && strings.HasPrefix(rest, "[CDATA["):
+
+		// It is a CDATA section if the HTML element was closed without an end
+		// tag, and the <svg> or <math> element before it is the current node.
+
+		return c.fail(UnclearForeignContent, i)
+	case strings.HasPrefix(rest, "--"):
+		return c.comment(i + len("<!--"))
+	case cdata && strings.HasPrefix(rest, "[CDATA["):
+		return c.cdataSection(i + len("<![CDATA["))
+	case strings.HasPrefix("--", rest) || hasPrefixFold("doctype", rest) ||
+		cdata && strings.HasPrefix("[CDATA[", rest):
+		return c.fail(SplitSequence, i)
+	}
+	return c.bogusComment(i, i+len("<!"))
+}
+
+// bogusComment reads a doctype or a bogus comment that starts at index start,
+// from index i, to the next ">".
+//
+func (c *contextScanner) bogusComment(start, i int) (int, bool) {
+	j := strings.IndexByte(c.s[i:], '>')
+	if j == -1 {
+		return c.fail(InMarkupDeclaration, start)
+	}
+	return i + j + 1, false
+}
+
+// comment reads the comment text at index i, after its "<!--".
+//
+func (c *contextScanner) comment(i int) (int, bool) {
+
+	text := c.s[i:]
+
+	// "<!-->" and "<!--->" are empty comments.
+
+	if strings.HasPrefix(text, ">") {
+		return i + len(">"), false
+	}
+	if strings.HasPrefix(text, "->") {
+		return i + len("->"), false
+	}
+
+	end := strings.Index(text, "-->")
+	if j := strings.Index(text, "--!>"); j != -1 && (end == -1 || j < end) {
+		return i + j + len("--!>"), false
+	}
+	if end != -1 {
+		return i + end + len("-->"), false
+	}
+
+	if endsWithPartial(text, "--!>") || endsWithPartial(text, "<!--") {
+		return c.fail(SplitSequence, i)
+	}
+	return c.result(Comment)
+}
+
+// cdataSection reads the CDATA section content at index i.
+//
+func (c *contextScanner) cdataSection(i int) (int, bool) {
+
+	text := c.s[i:]
+	if j := strings.Index(text, cdataEnd); j != -1 {
+		return i + j + len(cdataEnd), false
+	}
+
+	if endsWithPartial(text, cdataEnd) {
+		return c.fail(SplitSequence, i)
+	}
+	return c.result(CDATA)
+}
+
+// tag reads the tag that starts with the "<" at index start, with its name at
+// index i.
+//
+func (c *contextScanner) tag(start, i int, isEndTag bool) (int, bool) {
+
+	s := c.s
+
+	nameStart := i
+	for i < len(s) && !isTagNameEnd(s[i]) {
+		i++
+	}
+	if i == len(s) {
+		return c.fail(InTag, start)
+	}
+	t := tagToken{name: strings.ToLower(s[nameStart:i]), isEndTag: isEndTag}
+
+	var hasEncoding bool
+	for {
+
+		// Before an attribute name.
+
+		i = skipSpaceCharacters(s, i)
+		if i == len(s) {
+			return c.fail(InTag, start)
+		}
+
+		switch s[i] {
+		case '>':
+			return c.afterTag(t, start, i+1)
+		case '/':
+			i++
+			if i == len(s) {
+				return c.fail(InTag, start)
+			}
+			if s[i] == '>' {
+				t.selfClosing = true
+				return c.afterTag(t, start, i+1)
+			}
+			continue
+		}
+
+		// The attribute name. Its first character can be "=". Only the first
+		// of the attributes with the same name counts.
+
+		attrStart := i
+		i++
+		for i < len(s) && !isTagNameEnd(s[i]) && s[i] != '=' {
+			i++
+		}
+		attr := strings.ToLower(s[attrStart:i])
+
+		var value *string // Where to keep the value, if anywhere.
+		switch {
+		case attr == "color" || attr == "face" || attr == "size":
+			t.fontAttribute = true
+		case attr == "name" || attr == "itemprop":
+			t.hasName = true
+		case attr == "encoding" && !hasEncoding:
+			hasEncoding = true
+			value = &t.encoding
+		case attr == "http-equiv" && !t.hasHTTPEquiv:
+			t.hasHTTPEquiv = true
+			value = &t.httpEquiv
+		}
+
+		i = skipSpaceCharacters(s, i)
+		if i == len(s) {
+			return c.fail(InTag, start)
+		}
+		if s[i] != '=' {
+			continue
+		}
+
+		// The attribute value.
+
+		i = skipSpaceCharacters(s, i+1)
+		if i == len(s) {
+			return c.attributeValue(&t, attr, 0, i)
+		}
+
+		valueStart := i
+		switch quote := s[i]; quote {
+		case '>':
+			return c.afterTag(t, start, i+1)
+		case '"', '\'':
+			j := strings.IndexByte(s[i+1:], quote)
+			if j == -1 {
+				return c.attributeValue(&t, attr, quote, i+1)
+			}
+			valueStart = i + 1
+			i += 1 + j + 1
+			if value != nil {
+				*value = s[valueStart : i-1]
+			}
+		default:
+			for i < len(s) && !isSpaceCharacter(s[i]) && s[i] != '>' {
+				i++
+			}
+			if i == len(s) {
+				return c.attributeValue(&t, attr, 0, valueStart)
+			}
+			if value != nil {
+				*value = s[valueStart:i]
+			}
+		}
+	}
+}
+
+// tagToken is what afterTag needs to know about a tag.
+//
+type tagToken struct {
+	name          string // The lowercase tag name.
+	isEndTag      bool
+	selfClosing   bool
+	fontAttribute bool   // It has a color, face, or size attribute.
+	encoding      string // The value of its encoding attribute.
+	hasName       bool   // It has a name or itemprop attribute.
+	hasHTTPEquiv  bool
+	httpEquiv     string // The value of its http-equiv attribute.
+}
+
+// attributeValue sets the result of ContextAt for a prefix that ends in the
+// value of the attribute of the tag t, in quote, or 0 if the value is
+// unquoted. The value starts at index i. Only the attributes before it are in
+// t.
+//
+func (c *contextScanner) attributeValue(t *tagToken, attr string, quote byte, i int) (int, bool) {
+
+	ctx := AttrUnquoted
+	switch quote {
+	case '"':
+		ctx = AttrDouble
+	case '\'':
+		ctx = AttrSingle
+	}
+
+	switch {
+	case strings.HasPrefix(attr, "on"):
+		return c.fail(InScriptAttribute, i)
+	case attr == "style":
+		return c.fail(InStyleAttribute, i)
+	case attr == "srcset" || attr == "imagesrcset":
+		return c.fail(InSrcsetAttribute, i)
+	case attr == "srcdoc":
+		return c.fail(InSrcdocAttribute, i)
+	case animationElements[t.name] && animationAttributes[attr]:
+		return c.fail(InAnimationAttribute, i)
+	case t.name == "meta" && attr == "content" && mayBeRefresh(t):
+		return c.fail(InRefreshAttribute, i)
+	case !urlAttributes[attr]:
+		return c.result(ctx)
+	}
+
+	// The scheme is read from the complete text only. A character reference
+	// at the end can still change, like "&#1" into "&#106;", a "j".
+
+	value := c.s[i:]
+	complete := value[:undecidedReference(value)]
+
+	var buf [128]byte
+	url := bytesToString(appendDecodedURL(buf[:0], complete))
+
+	switch {
+	case url == "" && quote != 0 && complete == value:
+		return c.result(URL)
+	case strings.HasPrefix(url, InvalidURL):
+		return c.result(ctx)
+	case strings.IndexAny(url, ":/?#") == -1 || !DefaultURLPolicy.allows(url):
+		return c.fail(InURLScheme, i)
+	}
+	return c.result(ctx)
+}
+
+// afterTag reads what follows a tag, at index i, which depends on the tag. The
+// tag starts at index start.
+//
+func (c *contextScanner) afterTag(t tagToken, start, i int) (int, bool) {
+
+	if t.isEndTag {
+		if !c.endTag(t.name) {
+			return c.fail(UnclearForeignContent, start)
+		}
+		return i, false
+	}
+
+	// In foreign content, a start tag is for an element of <svg> or <math>,
+	// unless it ends foreign content.
+
+	if e := c.current(); e != nil && !e.hasHTMLContent(t.name) {
+		if !breakoutTags[t.name] && !(t.name == "font" && t.fontAttribute) {
+			c.openForeignElement(e.ns, t, i)
+			return i, false
+		}
+		c.closeForeignElements()
+	}
+
+	switch {
+	case t.name == "svg" || t.name == "math":
+		if !t.selfClosing {
+			c.open = append(c.open, openElement{name: t.name, ns: t.name, kind: foreignElement, content: i})
+		}
+		return i, false
+	case len(c.open) == 0:
+	case tableTags[t.name]:
+		return c.fail(UnclearForeignContent, start)
+	case !voidElements[t.name]:
+		c.open = append(c.open, openElement{name: t.name, kind: htmlElement, content: i})
+	}
+
+	switch t.name {
+	case "script":
+		return c.script(i)
+	case "style":
+		return c.style(i)
+	case "textarea", "title":
+		return c.rawText(t.name, i, RCDATA)
+	case "iframe", "noembed", "noframes", "noscript", "xmp":
+		return c.rawText(t.name, i, -1)
+	case "plaintext":
+		return c.fail(InPlaintext, i)
+	}
+	return i, false
+}
+
+// How the content of an open element is read, for ContextAt.
+const (
+	htmlElement          = iota // An HTML element.
+	foreignElement              // An element of <svg> or <math>.
+	htmlIntegrationPoint        // An element of <svg> or <math> with HTML content.
+	textIntegrationPoint        // Like htmlIntegrationPoint, but <mglyph> and <malignmark> are MathML.
+)
+
+// openElement is an element that is open in or under foreign content. The
+// elements outside of the outermost <svg> or <math> aren't kept track of.
+//
+type openElement struct {
+	name    string // The lowercase tag name.
+	ns      string // "svg" or "math", or "" for an HTML element.
+	kind    int
+	content int // The index in the prefix that its content starts at.
+}
+
+// hasHTMLContent returns true if a start tag with the name, in the element e,
+// is for an HTML element.
+//
+// From https://html.spec.whatwg.org/multipage/parsing.html#tree-construction-dispatcher
+//
+func (e *openElement) hasHTMLContent(name string) bool {
+	switch e.kind {
+	case htmlElement, htmlIntegrationPoint:
+		return true
+	case textIntegrationPoint:
+		return name != "mglyph" && name != "malignmark"
+	}
+	return e.ns == "math" && e.name == "annotation-xml" && name == "svg"
+}
+
+// current returns the innermost open element, or nil if the prefix isn't in
+// or under foreign content.
+//
+func (c *contextScanner) current() *openElement {
+	if len(c.open) == 0 {
+		return nil
+	}
+	return &c.open[len(c.open)-1]
+}
+
+// openForeignElement opens the element of the start tag t, in the namespace
+// ns, with its content at index i, unless the tag is self-closing.
+//
+// From https://html.spec.whatwg.org/multipage/parsing.html#html-integration-point
+//
+func (c *contextScanner) openForeignElement(ns string, t tagToken, i int) {
+
+	if t.selfClosing {
+		return
+	}
+
+	kind := foreignElement
+	switch ns {
+	case "svg":
+		switch t.name {
+		case "foreignobject", "desc", "title":
+			kind = htmlIntegrationPoint
+		}
+	case "math":
+		switch t.name {
+		case "mi", "mo", "mn", "ms", "mtext":
+			kind = textIntegrationPoint
+		case "annotation-xml":
+			encoding := UnescapeAttributeValue(t.encoding)
+			if strings.EqualFold(encoding, "text/html") || strings.EqualFold(encoding, "application/xhtml+xml") {
+				kind = htmlIntegrationPoint
+			}
+		}
+	}
+	c.open = append(c.open, openElement{name: t.name, ns: ns, kind: kind, content: i})
+}
+
+// closeForeignElements closes the elements of <svg> and <math> up to the
+// innermost integration point or HTML element, as a tag that ends foreign
+// content does.
+//
+func (c *contextScanner) closeForeignElements() {
+	for len(c.open) > 0 && c.current().kind == foreignElement {
+		c.open = c.open[:len(c.open)-1]
+	}
+}
+
+// endTag closes the element of the end tag with the name. It returns false if
+// it can't tell which element that is, because the tag could close elements
+// outside of the ones that are kept track of, or ones that were closed
+// without an end tag.
+//
+// From https://html.spec.whatwg.org/multipage/parsing.html#parsing-main-inforeign
+//
+func (c *contextScanner) endTag(name string) bool {
+
+	e := c.current()
+	if e == nil {
+		return true
+	}
+
+	// Outside of an HTML element, </br> and </p> end foreign content. Then
+	// </br> is read as <br>, and </p> as <p></p> unless a <p> is open.
+
+	if name == "br" || name == "p" && e.kind != htmlElement {
+		c.closeForeignElements()
+		if e = c.current(); e == nil || name == "br" || e.kind != htmlElement {
+			return true
+		}
+	}
+
+	for j := len(c.open) - 1; j >= 0; j-- {
+		if c.open[j].name == name {
+			c.open = c.open[:j]
+			return true
+		}
+		if c.open[j].kind == htmlElement {
+			break
+		}
+	}
+	return false
+}
+
+// rawText reads the content of the raw text or escapable raw text element at
+// index i. If ctx is -1, a prefix that ends in it has no context.
+//
+func (c *contextScanner) rawText(name string, i int, ctx Context) (int, bool) {
+
+	text := c.s[i:]
+	if j := checker.IndexEndTag(text, name, checker.EndTagTerminators); j != -1 {
+		return i + j, false
+	}
+
+	switch {
+	case endsWithPartial(text, "</"+name):
+		return c.fail(SplitSequence, i)
+	case ctx == -1:
+		return c.fail(InRawTextElement, i)
+	}
+	return c.result(ctx)
+}
+
+// style reads the content of a <style> element at index i.
+//
+func (c *contextScanner) style(i int) (int, bool) {
+
+	text := c.s[i:]
+	if j := checker.IndexEndTag(text, "style", checker.EndTagTerminators); j != -1 {
+		return i + j, false
+	}
+
+	if endsWithPartial(text, "</style") {
+		return c.fail(SplitSequence, i)
+	}
+
+	switch state, start := scanCSS(text); state {
+	case inString:
+		return c.result(CSSString)
+	case inEscape:
+		return c.fail(SplitSequence, i+start)
+	}
+	return c.fail(InRawTextElement, i)
+}
+
+// script reads the content of a <script> element at index i.
+//
+func (c *contextScanner) script(i int) (int, bool) {
+
+	text := c.s[i:]
+	if j := indexScriptEnd(text); j != -1 {
+		return i + j, false
+	}
+
+	if endsWithPartial(text, "</script") || endsWithPartial(text, "<script") ||
+		endsWithPartial(text, "<!--") {
+		return c.fail(SplitSequence, i)
+	}
+
+	switch state, start := scanJS(text); state {
+	case inString:
+		return c.result(JSString)
+	case inEscape:
+		return c.fail(SplitSequence, i+start)
+	case inToken:
+		return c.fail(InScriptToken, i+start)
+	}
+	return c.result(RawText)
+}
+
+// indexScriptEnd returns the index of the end tag that ends the script data at
+// the start of s, or -1 if there isn't one. It follows the script data states
+// of the tokenizer, where "<!--" followed by "<script" hides the next
+// "</script>". See checker.IsValidRawText.
+//
+func indexScriptEnd(s string) int {
+
+	var escaped, doubleEscaped bool
+
+	for i := 0; i < len(s); i++ {
+		rest := s[i:]
+		switch {
+		case !escaped && strings.HasPrefix(rest, "<!--"):
+			escaped = true
+			i += len("<") // The "--" can be part of a "-->", as in "<!-->".
+		case escaped && strings.HasPrefix(rest, "-->"):
+			escaped, doubleEscaped = false, false
+			i += len("--")
+		case isScriptTag(rest, "</script"):
+			if !doubleEscaped {
+				return i
+			}
+			doubleEscaped = false
+			i += len("</script") - 1
+		case escaped && !doubleEscaped && isScriptTag(rest, "<script"):
+			doubleEscaped = true
+			i += len("<script") - 1
+		}
+	}
+	return -1
+}
+
+// isScriptTag returns true if s starts with open, in any case, followed by one
+// of the EndTagTerminators.
+//
+func isScriptTag(s, open string) bool {
+	return len(s) > len(open) && hasPrefixFold(s, open) &&
+		strings.IndexByte(checker.EndTagTerminators, s[len(open)]) != -1
+}
+
+// Where a script or a style sheet ends, as returned by scanJS and scanCSS.
+const (
+	inCode   = iota
+	inString // In a string literal.
+	inEscape // Right after a backslash in a string literal.
+	inToken  // In a comment, template literal, or regular expression literal.
+)
+
+// scanCSS returns where the style sheet ends, and the index of the string
+// literal or comment it ends in.
+//
+func scanCSS(css string) (state, start int) {
+
+	for i := 0; i < len(css); i++ {
+		switch c := css[i]; c {
+		case '\\':
+			i++
+		case '/':
+			if strings.HasPrefix(css[i:], "/*") {
+				j := strings.Index(css[i+len("/*"):], "*/")
+				if j == -1 {
+					return inToken, i
+				}
+				i += len("/*") + j + len("*/") - 1
+			}
+		case '"', '\'':
+			start := i
+			for i++; ; i++ {
+				if i == len(css) {
+					return inString, start
+				}
+				if css[i] == '\\' {
+					i++
+					if i == len(css) {
+						return inEscape, start
+					}
+					continue
+				}
+				// A newline ends the string, as a bad string.
+				if css[i] == c || css[i] == '\n' || css[i] == '\r' || css[i] == '\f' {
+					break
+				}
+			}
+		}
+	}
+	return inCode, 0
+}
+
+// scanJS returns where the script ends, and the index of the string literal,
+// comment, template literal, or regular expression literal it ends in.
+//
+// In a script, "<!--" starts a comment to the end of the line, like "//".
+//
+// Whether a "/" starts a regular expression or is a division depends on the
+// token before it. Like html/template, scanJS guesses from the last token,
+// which is right for sensible code.
+//
+func scanJS(js string) (state, start int) {
+
+	var substitutions []int // The depth of braces in each open "${".
+	regexp := true          // Whether a "/" would start a regular expression.
+
+	for i := 0; i < len(js); {
+
+		c := js[i]
+		switch {
+
+		case c == '"' || c == '\'':
+			start := i
+			for i++; ; i++ {
+				if i == len(js) {
+					return inString, start
+				}
+				if js[i] == '\\' {
+					i++
+					if i == len(js) {
+						return inEscape, start
+					}
+					continue
+				}
+				if js[i] == c || js[i] == '\n' || js[i] == '\r' {
+					break
+				}
+			}
+			i++
+			regexp = false
+
+		case c == '`' || c == '}' && len(substitutions) > 0 && substitutions[len(substitutions)-1] == 0:
+			if c == '}' {
+				substitutions = substitutions[:len(substitutions)-1]
+			}
+			start := i
+			for i++; ; i++ {
+				if i >= len(js) {
+					return inToken, start
+				}
+				if js[i] == '\\' {
+					i++
+					continue
+				}
+				if js[i] == '`' {
+					i++
+					regexp = false
+					break
+				}
+				if strings.HasPrefix(js[i:], "${") {
+					i += len("${")
+					substitutions = append(substitutions, 0)
+					regexp = true
+					break
+				}
+			}
+
+		case strings.HasPrefix(js[i:], "//") || strings.HasPrefix(js[i:], "<!--"):
+			j := strings.IndexAny(js[i:], "\n\r")
+			if j == -1 {
+				return inToken, i
+			}
+			i += j
+
+		case strings.HasPrefix(js[i:], "/*"):
+			j := strings.Index(js[i+len("/*"):], "*/")
+			if j == -1 {
+				return inToken, i
+			}
+			i += len("/*") + j + len("*/")
+
+		case c == '/' && regexp:
+			start := i
+			inClass := false
+			for i++; ; i++ {
+				if i >= len(js) {
+					return inToken, start
+				}
+				if js[i] == '\\' {
+					i++
+					continue
+				}
+				if js[i] == '[' {
+					inClass = true
+				} else if js[i] == ']' {
+					inClass = false
+				} else if js[i] == '/' && !inClass || js[i] == '\n' || js[i] == '\r' {
+					break
+				}
+			}
+			i++
+			regexp = false
+
+		case isJSWordByte(c) || c == '.' && i+1 < len(js) && isASCIIDigit(js[i+1]):
+			start := i
+			number := !isJSIdentifierStart(c)
+			for i < len(js) && (isJSWordByte(js[i]) || number && js[i] == '.') {
+				i++
+			}
+			regexp = !number && regexpPrecederKeywords[js[start:i]]
+
+		case isSpaceCharacter(c):
+			i++
+
+		default:
+			switch c {
+			case ')', ']':
+				regexp = false
+			case '+', '-':
+				// A "++" or "--" is most likely postfix, which ends an
+				// expression, while a single "+" or "-" is an operator.
+				n := 1
+				for n <= i && js[i-n] == c {
+					n++
+				}
+				regexp = n%2 == 1
+			case '{':
+				if len(substitutions) > 0 {
+					substitutions[len(substitutions)-1]++
+				}
+				regexp = true
+			case '}':
+				if len(substitutions) > 0 {
+					substitutions[len(substitutions)-1]--
+				}
+				regexp = true
+			default:
+				regexp = true
+			}
+			i++
+		}
+	}
+
+	return inCode, 0 // Code in a template substitution is still code.
+}
+
+// regexpPrecederKeywords are the keywords that a regular expression literal
+// can follow. After any other word, a "/" is a division.
+//
+var regexpPrecederKeywords = map[string]bool{
+	"break":      true,
+	"case":       true,
+	"continue":   true,
+	"delete":     true,
+	"do":         true,
+	"else":       true,
+	"finally":    true,
+	"in":         true,
+	"instanceof": true,
+	"return":     true,
+	"throw":      true,
+	"try":        true,
+	"typeof":     true,
+	"void":       true,
+}
+
+// urlAttributes are the attributes whose values are URLs.
+//
+var urlAttributes = map[string]bool{
+	"action":     true,
+	"archive":    true,
+	"background": true,
+	"cite":       true,
+	"classid":    true,
+	"codebase":   true,
+	"data":       true,
+	"formaction": true,
+	"href":       true,
+	"icon":       true,
+	"longdesc":   true,
+	"manifest":   true,
+	"ping":       true,
+	"poster":     true,
+	"profile":    true,
+	"src":        true,
+	"usemap":     true,
+	"xlink:href": true,
+	"xmlns":      true,
+}
+
+// animationElements are the SVG animation elements that can set the value of
+// another attribute, like an href, with animationAttributes.
+//
+var animationElements = map[string]bool{
+	"animate": true, "animatemotion": true, "animatetransform": true,
+	"set": true,
+}
+
+var animationAttributes = map[string]bool{
+	"by": true, "from": true, "to": true, "values": true,
+}
+
+// mayBeRefresh returns true if the <meta> tag t may be a refresh, whose
+// content attribute has a URL. A <meta> with a name or itemprop attribute
+// before the content is taken to be metadata, unless it is a refresh, too.
+//
+func mayBeRefresh(t *tagToken) bool {
+	if t.hasHTTPEquiv {
+		return strings.EqualFold(UnescapeAttributeValue(t.httpEquiv), "refresh")
+	}
+	return !t.hasName
+}
+
+// tableTags are the start tags that can close the open elements up to a
+// <table>, past an integration point, so they can end foreign content.
+//
+var tableTags = map[string]bool{
+	"caption": true, "col": true, "colgroup": true, "table": true,
+	"tbody": true, "td": true, "tfoot": true, "th": true, "thead": true,
+	"tr": true,
+}
+
+// voidElements are the HTML elements that have no content or end tag.
+//
+var voidElements = map[string]bool{
+	"area": true, "base": true, "basefont": true, "bgsound": true, "br": true,
+	"col": true, "embed": true, "frame": true, "hr": true, "image": true,
+	"img": true, "input": true, "keygen": true, "link": true, "meta": true,
+	"param": true, "source": true, "track": true, "wbr": true,
+}
+
+// breakoutTags are the start tags that end foreign content. So does <font>
+// with a color, face, or size attribute.
+//
+// From https://html.spec.whatwg.org/multipage/parsing.html#parsing-main-inforeign
+//
+var breakoutTags = map[string]bool{
+	"b": true, "big": true, "blockquote": true, "body": true, "br": true,
+	"center": true, "code": true, "dd": true, "div": true, "dl": true,
+	"dt": true, "em": true, "embed": true, "h1": true, "h2": true, "h3": true,
+	"h4": true, "h5": true, "h6": true, "head": true, "hr": true, "i": true,
+	"img": true, "li": true, "listing": true, "menu": true, "meta": true,
+	"nobr": true, "ol": true, "p": true, "pre": true, "ruby": true, "s": true,
+	"small": true, "span": true, "strong": true, "strike": true, "sub": true,
+	"sup": true, "table": true, "tt": true, "u": true, "ul": true, "var": true,
+}
+
+// endsWithPartial returns true if s ends with the start of seq, or all of it,
+// ignoring the case of ASCII letters.
+//
+func endsWithPartial(s, seq string) bool {
+	for n := len(seq); n > 0; n-- {
+		if n <= len(s) && strings.EqualFold(s[len(s)-n:], seq[:n]) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTagNameEnd returns true if c ends a tag name or an attribute name.
+//
+func isTagNameEnd(c byte) bool {
+	return c == '/' || c == '>' || isSpaceCharacter(c)
+}
+
+// isSpaceCharacter returns true if c is one of checker.SpaceCharacters.
+//
+func isSpaceCharacter(c byte) bool {
+	return strings.IndexByte(checker.SpaceCharacters, c) != -1
+}
+
+// skipSpaceCharacters returns the index of the first byte at or after i in s
+// that isn't a space character, or len(s).
+//
+func skipSpaceCharacters(s string, i int) int {
+	for i < len(s) && isSpaceCharacter(s[i]) {
+		i++
+	}
+	return i
+}
+
+func isASCIIAlpha(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isASCIIHexDigit(c byte) bool {
+	return isASCIIDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+// isJSIdentifierStart returns true if c can start a JS identifier, counting
+// every non-ASCII byte.
+//
+func isJSIdentifierStart(c byte) bool {
+	return isASCIIAlpha(c) || c == '_' || c == '$' || c >= 0x80
+}
+
+// isJSWordByte returns true if c can be part of a JS identifier or number.
+//
+func isJSWordByte(c byte) bool {
+	return isJSIdentifierStart(c) || isASCIIDigit(c)
+}
@@ -0,0 +1,268 @@
+package escaper
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestContextAt(t *testing.T) {
+
+	cases := map[string]Context{
+		"":                                   Text,
+		"Hello, ":                            Text,
+		"<p>Hello, ":                         Text,
+		"a < b, ":                            Text,
+		"<p title='a>b'>":                    Text,
+		"<!-- x --><p>":                      Text,
+		"<!---->":                            Text,
+		"<!-->":                              Text,
+		"<!DOCTYPE html><html>":              Text,
+		"<?xml version='1.0'?>":              Text,
+		"</>":                                Text,
+		"<textarea>":                         RCDATA,
+		"<TITLE>a <b> ":                      RCDATA,
+		"<textarea></textarea>":              Text,
+		"<title></titles> ":                  RCDATA,
+		"<script>":                           RawText,
+		"<script>var x = ":                   RawText,
+		"<script type=module>f(1);</script>": Text,
+		"<script>var s = '":                  JSString,
+		`<script>var s = "a\"b`:              JSString,
+		"<script>var s = '</scr' + 'ipt>'; ": RawText,
+		"<script>var t = `a` + ":             RawText,
+		"<script>var t = `${a + '":           JSString,
+		"<script>var t = `${ {a: 1} }` + ":   RawText,
+		"<script>var r = /a'b/; ":            RawText,
+		"<script>var n = a / b / '":          JSString,
+		"<script>x = a++ / 2; s = '":         JSString,
+		"<script>return /'/.test(s) && '":    JSString,
+		"<script>// it's\n":                  RawText,
+		"<script>/* it's */ ":                RawText,
+		"<script><!--\n<script></script>\nvar s = '": JSString,
+		"<script><!--\n<script></script></script>":   Text,
+		"<style>a { content: \"":                     CSSString,
+		"<style>a { content: '\\'":                   CSSString,
+		"<style>a{}</style>":                         Text,
+		"<!--":                                       Comment,
+		"<!-- a ":                                    Comment,
+		"<!-- a --!> b":                              Text,
+		"<svg><![CDATA[":                             CDATA,
+		"<svg><![CDATA[a]]></svg>":                   Text,
+		"<svg/><style>a{content:'":                   CSSString,
+		"<svg><p><style>a{content:'":                 CSSString,
+		"<math><svg></svg><script>":                  Text,
+		"<svg><foreignObject><script>var x = \"":     JSString,
+		"<svg><desc><script>var x = \"":              JSString,
+		"<svg><title><textarea>":                     RCDATA,
+		"<svg><foreignObject><![CDATA[":              CDATA,
+		"<svg><foreignObject><p></p></foreignObject></svg><script>":     RawText,
+		"<math><mi><script>var x = \"":                                  JSString,
+		"<math><mtext><mglyph><script>":                                 Text,
+		"<math><annotation-xml><script>":                                Text,
+		"<math><annotation-xml encoding='TEXT/HTML'><script>":           RawText,
+		"<math><annotation-xml encoding=application/xhtml+xml><script>": RawText,
+		"<math><annotation-xml><svg><desc><style>a{content:'":           CSSString,
+		"<svg><font color=red><script>var x = \"":                       JSString,
+		"<svg><script><!-- ":                                            Comment,
+		"<svg><script>x()</script>":                                     Text,
+		"<svg><g></p><script>":                                          RawText,
+		"<svg><g></br><script>":                                         RawText,
+		"<a title=\"":                                                   AttrDouble,
+		"<a title='":                                                    AttrSingle,
+		"<a title=":                                                     AttrUnquoted,
+		"<a title = ":                                                   AttrUnquoted,
+		"<a title=x":                                                    AttrUnquoted,
+		"<a title=\"x\" alt='y":                                         AttrSingle,
+		"<a b=\"'\" c='":                                                AttrSingle,
+		"<a href=\"":                                                    URL,
+		"<a HREF='  ":                                                   URL,
+		"<img src=\"/x?q=":                                              AttrDouble,
+		"<a href='https://example.com/":                                 AttrSingle,
+		"<a href=\"#":                                                   AttrDouble,
+		"<a href=\"about:invalid":                                       AttrDouble,
+		"<a href=/x?q=":                                                 AttrUnquoted,
+		"<a href=\"/x?q=&#1":                                            AttrDouble,
+		"<a href=\"https://x/&amp":                                      AttrDouble,
+		"<a href=\"&#47;x":                                              AttrDouble,
+		"<a href=\"x\" title=\"":                                        AttrDouble,
+		"<a data-href=\"":                                               AttrDouble,
+		"<meta name=description content=\"":                             AttrDouble,
+		"<meta http-equiv=content-type content='":                       AttrSingle,
+		"<svg><feColorMatrix values=\"":                                 AttrDouble,
+		"<a href=\"x\">":                                                Text,
+		"</p title='":                                                   AttrSingle,
+	}
+
+	for prefix, expected := range cases {
+		actual, err := ContextAt(prefix)
+		if err != nil {
+			t.Errorf("ContextAt(%q) gives the error %q, but expected %v.", prefix, err, expected)
+		} else if actual != expected {
+			t.Errorf("ContextAt(%q) is %v, but expected %v.", prefix, actual, expected)
+		}
+	}
+}
+
+func TestContextAt_errors(t *testing.T) {
+
+	cases := map[string]ContextError{
+		"<a":                                {InTag, "<a"},
+		"<a hr":                             {InTag, "<a hr"},
+		"<a href":                           {InTag, "<a href"},
+		"<a href=\"x\" ":                    {InTag, "<a href=\"x\" "},
+		"<br/":                              {InTag, "<br/"},
+		"</p":                               {InTag, "</p"},
+		"<!DOCTYPE ht":                      {InMarkupDeclaration, "<!DOCTYPE ht"},
+		"<?xml":                             {InMarkupDeclaration, "<?xml"},
+		"</ x":                              {InMarkupDeclaration, "</ x"},
+		"<![CDATA[":                         {InMarkupDeclaration, "<![CDATA["},
+		"<plaintext>a":                      {InPlaintext, "a"},
+		"<style>a { color: ":                {InRawTextElement, "a { color: "},
+		"<style>/* '":                       {InRawTextElement, "/* '"},
+		"<iframe>":                          {InRawTextElement, ""},
+		"<noscript>x":                       {InRawTextElement, "x"},
+		"<script>// ":                       {InScriptToken, "// "},
+		"<script>/* ":                       {InScriptToken, "/* "},
+		"<script>x = `a":                    {InScriptToken, "`a"},
+		"<script>x = `${y}":                 {InScriptToken, "}"},
+		"<script>x = /a":                    {InScriptToken, "/a"},
+		"<script>return /":                  {InScriptToken, "/"},
+		"<a onclick=\"":                     {InScriptAttribute, ""},
+		"<a ONCLICK='f(":                    {InScriptAttribute, "f("},
+		"<a style=\"color: ":                {InStyleAttribute, "color: "},
+		"<img srcset=\"a.png ":              {InSrcsetAttribute, "a.png "},
+		"<a href=\"java":                    {InURLScheme, "java"},
+		"<a href=\"javascript:":             {InURLScheme, "javascript:"},
+		"<a href=\"javascript&#58;":         {InURLScheme, "javascript&#58;"},
+		"<a href=\"java\tscript:":           {InURLScheme, "java\tscript:"},
+		"<a href=\"&#1":                     {InURLScheme, "&#1"},
+		"<a href=\"java&#":                  {InURLScheme, "java&#"},
+		"<a href=\"java&#x":                 {InURLScheme, "java&#x"},
+		"<a href=\"&":                       {InURLScheme, "&"},
+		"<a href='javascript&colon":         {InURLScheme, "javascript&colon"},
+		"<a href=\"x&#58":                   {InURLScheme, "x&#58"},
+		"<a href=":                          {InURLScheme, ""},
+		"<a href=x":                         {InURLScheme, "x"},
+		"a <":                               {SplitSequence, "<"},
+		"</":                                {SplitSequence, "</"},
+		"<!":                                {SplitSequence, "<!"},
+		"<!-":                               {SplitSequence, "<!-"},
+		"<!DOC":                             {SplitSequence, "<!DOC"},
+		"<svg><![CDA":                       {SplitSequence, "<![CDA"},
+		"<!-- a -":                          {SplitSequence, " a -"},
+		"<!-- a --!":                        {SplitSequence, " a --!"},
+		"<!-- a <!":                         {SplitSequence, " a <!"},
+		"<svg><![CDATA[a]":                  {SplitSequence, "a]"},
+		"<textarea>a</TextAr":               {SplitSequence, "a</TextAr"},
+		"<textarea>a</textarea":             {SplitSequence, "a</textarea"},
+		"<script>x</scr":                    {SplitSequence, "x</scr"},
+		"<script>x<!-":                      {SplitSequence, "x<!-"},
+		"<script>x = '\\":                   {SplitSequence, "'\\"},
+		"<style>a{content:'\\":              {SplitSequence, "'\\"},
+		"<style></sty":                      {SplitSequence, "</sty"},
+		"<iframe></ifr":                     {SplitSequence, "</ifr"},
+		"<div><svg></div><script>":          {UnclearForeignContent, "</div><script>"},
+		"<svg></g><script>":                 {UnclearForeignContent, "</g><script>"},
+		"<svg><desc><b></desc>":             {UnclearForeignContent, "</desc>"},
+		"<svg><desc><td>":                   {UnclearForeignContent, "<td>"},
+		"<svg><desc><p><![CDATA[":           {UnclearForeignContent, "<![CDATA["},
+		"<svg><style>":                      {InSVGScriptOrStyle, ""},
+		"<svg><script>alert(1); ":           {InSVGScriptOrStyle, "alert(1); "},
+		"<svg><script><![CDATA[x = 1; ":     {InSVGScriptOrStyle, "<![CDATA[x = 1; "},
+		"<svg><foreignObject><svg><script>": {InSVGScriptOrStyle, ""},
+		"<svg><foreignObject></foreignObject><script>":          {InSVGScriptOrStyle, ""},
+		"<svg><foreignObject/><script>":                         {InSVGScriptOrStyle, ""},
+		"<svg><font><script>":                                   {InSVGScriptOrStyle, ""},
+		"<iframe srcdoc=\"":                                     {InSrcdocAttribute, ""},
+		"<iframe SRCDOC='<p>":                                   {InSrcdocAttribute, "<p>"},
+		"<svg><set attributeName=href to=\"":                    {InAnimationAttribute, ""},
+		"<svg><animate attributeName=href values=\"https://x/;": {InAnimationAttribute, "https://x/;"},
+		"<svg><animate from=":                                   {InAnimationAttribute, ""},
+		"<meta http-equiv=refresh content=\"":                   {InRefreshAttribute, ""},
+		"<meta http-equiv='Refresh' content='0; url=":           {InRefreshAttribute, "0; url="},
+		"<meta name=x http-equiv=refresh content=\"":            {InRefreshAttribute, ""},
+		"<meta content=\"":                                      {InRefreshAttribute, ""},
+	}
+
+	for prefix, expected := range cases {
+		ctx, err := ContextAt(prefix)
+		actual, ok := err.(*ContextError)
+		if !ok || *actual != expected {
+			t.Errorf("ContextAt(%q) gives the error %v, but expected %v.", prefix, err, &expected)
+		}
+		if ctx != NoContext {
+			t.Errorf("ContextAt(%q) is %v with an error, but expected NoContext.", prefix, ctx)
+		}
+	}
+}
+
+func TestContextAt_escaped(t *testing.T) {
+
+	// Appending escaped text to a prefix keeps the document in the same
+	// context, unless it ends partway through something, like a "<". RawText
+	// is left out, because the text is code there, and so is URL, because
+	// the text can be the start of a scheme.
+
+	prefixes := []string{
+		"<p>", "<textarea>", "<title>", "<script>var s = '",
+		"<style>p { content: \"", "<!--", "<!-- a ", "<svg><![CDATA[",
+		"<a title=", "<a title='", "<a title=\"",
+	}
+	inputs := append([]string{
+		"<", "</", "<!", "</script>", "</textarea>", "</style>", "-->", "]]>",
+		`\`, `'`, `"`, "javascript:alert(1)", "a b", "=", "-", "--!",
+	}, writerTestInputs...)
+
+	for _, prefix := range prefixes {
+		ctx, err := ContextAt(prefix)
+		if err != nil {
+			t.Fatalf("ContextAt(%q) gives the error %q.", prefix, err)
+		}
+		for _, input := range inputs {
+			if ctx == AttrUnquoted && input == "" {
+				continue
+			}
+			doc := prefix + Escape(ctx, input)
+			after, err := ContextAt(doc)
+			if err, ok := err.(*ContextError); ok && err.Problem == SplitSequence {
+				continue
+			}
+			if err != nil || after != ctx {
+				t.Errorf("ContextAt(%q) is %v, %v, but expected %v.", doc, after, err, ctx)
+			}
+		}
+	}
+}
+
+func TestContextProblem_String(t *testing.T) {
+	if s := InURLScheme.String(); s != "in-url-scheme" {
+		t.Errorf("InURLScheme.String() is %q.", s)
+	}
+	if s := ContextProblem(-1).String(); s != "unknown-context-problem" {
+		t.Errorf("ContextProblem(-1).String() is %q.", s)
+	}
+}
+
+func ExampleContextAt() {
+	prefix := `<a href="/search?q=`
+	ctx, err := ContextAt(prefix)
+	fmt.Println(ctx, err)
+	fmt.Println(prefix + Escape(ctx, `"><script>`))
+
+	_, err = ContextAt(`<a onclick="`)
+	fmt.Println(err)
+	// Output:
+	// AttrDouble <nil>
+	// <a href="/search?q=&#34;><script>
+	// escaper: in-script-attribute in ""
+}
+
+// BenchmarkContextAt  1000000        1028 ns/op         0 B/op        0 allocs/op
+func BenchmarkContextAt(b *testing.B) {
+	prefix := `<!DOCTYPE html><html><head><title>Test</title><script>var s = "</script>` +
+		`</head><body><p class="intro">Hello, <a href="/search?q=`
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ContextAt(prefix)
+	}
+}
@@ -0,0 +1,188 @@
+package escaper
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// EscapeCSSIdentifier returns the argument escaped for use as a CSS
+// identifier, like the name in a class or ID selector. It gives the same
+// result as CSS.escape() in a browser:
+//
+//     U+0000 NULL                     becomes U+FFFD
+//     U+0001 to U+001F, U+007F        escaped as a code point, e.g. "\7f "
+//     a digit at the start            escaped as a code point, e.g. "\31 "
+//     a digit after a leading "-"     escaped as a code point
+//     "-" on its own                  escaped as "\-"
+//     "-", "_", [a-zA-Z0-9], U+0080+  unchanged
+//     anything else                   escaped with a backslash, e.g. "\."
+//
+// From https://drafts.csswg.org/cssom/#serialize-an-identifier
+//
+// Invalid UTF-8 is written as U+FFFD.
+//
+// The result uses the identifier syntax of CSS Syntax Level 3, which allows a
+// leading "--" and the characters U+0080 to U+009F. Use CSSIDSelector or
+// CSSClassSelector to get a selector that checker.IsValidCss3IdValue and
+// checker.IsValidCss3Identifier accept, too.
+//
+func EscapeCSSIdentifier(s string) string {
+	if b := escapeCSSIdentifier(s, false); b != nil {
+		return bytesToString(b)
+	}
+	return s
+}
+
+// AppendCSSIdentifier appends the argument, escaped as by EscapeCSSIdentifier,
+// to dst and returns the extended buffer. It doesn't allocate if dst has
+// enough capacity.
+//
+func AppendCSSIdentifier(dst []byte, s string) []byte {
+	return appendCSSIdentifier(dst, s, false)
+}
+
+// CSSIDSelector returns a selector that matches the element with the given
+// ID, like "#main", for use with querySelector or in a stylesheet. It escapes
+// the ID as EscapeCSSIdentifier does, and also escapes the first hyphen of a
+// leading "--" and the characters U+0080 to U+009F, so the result is accepted
+// by checker.IsValidCss3IdValue. For example, the ID "1abc" gives "#\31 abc".
+//
+// An empty ID has no selector, and gives "#", which is not valid.
+//
+func CSSIDSelector(id string) string {
+	return bytesToString(appendCSSIdentifier(append(make([]byte, 0, 1+escapedCapacity(id)), '#'), id, true))
+}
+
+// CSSClassSelector returns a selector that matches the elements with the given
+// class, like ".warning". It escapes the class name as CSSIDSelector does.
+//
+// An empty class name has no selector, and gives ".", which is not valid.
+//
+func CSSClassSelector(class string) string {
+	return bytesToString(appendCSSIdentifier(append(make([]byte, 0, 1+escapedCapacity(class)), '.'), class, true))
+}
+
+// How a code point is written by appendCSSIdentifier.
+const (
+	cssAsIs = iota
+	cssReplacement
+	cssCodePoint
+	cssCharacter
+)
+
+// cssEscapeKind returns how the code point r, at byte index i of s, is
+// written. If strict is true, it also escapes what CSS 2.1 doesn't allow in an
+// identifier.
+//
+func cssEscapeKind(s string, i int, r rune, strict bool) int {
+
+	switch {
+	case r == 0:
+		return cssReplacement
+	case r <= 0x1F || r == 0x7F:
+		return cssCodePoint
+	case r >= '0' && r <= '9' && (i == 0 || i == 1 && s[0] == '-'):
+		return cssCodePoint
+	case r == '-' && i == 0 && len(s) == 1:
+		return cssCharacter
+	case strict && r == '-' && i == 0 && s[1] == '-':
+		return cssCharacter
+	case strict && r >= 0x80 && r <= 0x9F:
+		return cssCodePoint
+	case r == utf8.RuneError && !strings.HasPrefix(s[i:], "\uFFFD"):
+		return cssReplacement // Invalid UTF-8 is written as U+FFFD.
+	case r >= 0x80 || r == '-' || r == '_' || isASCIIAlphanumeric(byte(r)):
+		return cssAsIs
+	}
+	return cssCharacter
+}
+
+// appendCSSIdentifier implements AppendCSSIdentifier, CSSIDSelector, and
+// CSSClassSelector.
+//
+func appendCSSIdentifier(dst []byte, s string, strict bool) []byte {
+
+	for i, r := range s {
+		switch cssEscapeKind(s, i, r, strict) {
+		case cssAsIs:
+			dst = utf8.AppendRune(dst, r)
+		case cssReplacement:
+			dst = utf8.AppendRune(dst, utf8.RuneError)
+		case cssCodePoint:
+			dst = append(dst, '\\')
+			dst = strconv.AppendInt(dst, int64(r), 16)
+			dst = append(dst, ' ')
+		case cssCharacter:
+			dst = append(dst, '\\', byte(r))
+		}
+	}
+	return dst
+}
+
+// escapeCSSIdentifier implements EscapeCSSIdentifier and its []byte version. It
+// returns nil if nothing needs to be escaped.
+//
+func escapeCSSIdentifier(s string, strict bool) []byte {
+
+	for i, r := range s {
+		if cssEscapeKind(s, i, r, strict) != cssAsIs {
+			return appendCSSIdentifier(make([]byte, 0, escapedCapacity(s)), s, strict)
+		}
+	}
+	return nil
+}
+
+// EscapeCSSString returns the argument escaped for use inside a CSS string
+// literal, enclosed in either kind of quote, in a <style> element or a quoted
+// style attribute, like the "..." in content: "...". Backslashes get a
+// backslash, and quotes, control characters, "<", ">", and "&" are written as
+// hexadecimal escapes followed by a space, like "\22 ", so nothing in the
+// result means anything to the HTML parser. U+0000 is written as "\fffd ",
+// which is what CSS turns it into anyway.
+//
+func EscapeCSSString(s string) string {
+	if b := escapeCSSString(s); b != nil {
+		return bytesToString(b)
+	}
+	return s
+}
+
+// AppendCSSString appends the argument, escaped as by EscapeCSSString, to dst
+// and returns the extended buffer. It doesn't allocate if dst has enough
+// capacity.
+//
+func AppendCSSString(dst []byte, s string) []byte {
+	return appendReplaced(dst, s, cssStringReplacements)
+}
+
+// escapeCSSString implements EscapeCSSString and its []byte version. It
+// returns nil if nothing needs to be escaped.
+//
+func escapeCSSString(s string) []byte {
+	if strings.IndexAny(s, cssStringReplacements.chars) == -1 {
+		return nil
+	}
+	return appendReplaced(make([]byte, 0, escapedCapacity(s)), s, cssStringReplacements)
+}
+
+// cssStringReplacements are the escapes for the characters EscapeCSSString
+// replaces.
+//
+var cssStringReplacements = func() *replacementSet {
+	set := &replacementSet{}
+	add := func(c byte, ref string) {
+		set.chars += string(rune(c))
+		set.refs[c] = ref
+	}
+	for c := byte(1); c < 0x20; c++ {
+		add(c, `\`+strconv.FormatInt(int64(c), 16)+" ")
+	}
+	add(0, `\fffd `)
+	add(0x7F, `\7f `)
+	add('\\', `\\`)
+	for _, c := range []byte(`"'<>&`) {
+		add(c, `\`+strconv.FormatInt(int64(c), 16)+" ")
+	}
+	return set
+}()
@@ -0,0 +1,96 @@
+package escaper
+
+import (
+	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
+	"testing"
+)
+
+func TestEscapeCSSIdentifier(t *testing.T) {
+
+	cases := map[string]string{
+		"":           "",
+		"main":       "main",
+		"a-b_c":      "a-b_c",
+		"1abc":       `\31 abc`,
+		"-1abc":      `-\31 abc`,
+		"-":          `\-`,
+		"--x":        "--x",
+		"-x":         "-x",
+		"a.b":        `a\.b`,
+		"a b":        `a\ b`,
+		"a#b:c":      `a\#b\:c`,
+		`a\b`:        `a\\b`,
+		"a\x00b":     "a\uFFFDb",
+		"a\x01b":     `a\1 b`,
+		"a\x7Fb":     `a\7f b`,
+		"a\u0080b":   "a\u0080b",
+		"café":       "café",
+		"a\xFFb":     "a\uFFFDb",
+		"a\uFFFDb":   "a\uFFFDb",
+		"12":         `\31 2`,
+		"--":         "--",
+		"_1":         "_1",
+		`"><script>`: `\"\>\<script\>`,
+	}
+
+	checkTestCases(t, cases, EscapeCSSIdentifier, "EscapeCSSIdentifier")
+}
+
+func TestCSSIDSelector(t *testing.T) {
+
+	cases := map[string]string{
+		"main":     "#main",
+		"1abc":     `#\31 abc`,
+		"--x":      `#\--x`,
+		"a\u0080b": `#a\80 b`,
+		"a.b":      `#a\.b`,
+	}
+
+	checkTestCases(t, cases, CSSIDSelector, "CSSIDSelector")
+}
+
+func TestCSSClassSelector(t *testing.T) {
+
+	cases := map[string]string{
+		"warning": ".warning",
+		"2col":    `.\32 col`,
+		"-":       `.\-`,
+	}
+
+	checkTestCases(t, cases, CSSClassSelector, "CSSClassSelector")
+}
+
+func TestAppendCSSIdentifier(t *testing.T) {
+	checkAppendAgrees(t, writerTestInputs, AppendCSSIdentifier, EscapeCSSIdentifier, "AppendCSSIdentifier")
+}
+
+func TestCSSIDSelector_valid(t *testing.T) {
+	for _, input := range writerTestInputs {
+		if input == "" {
+			continue
+		}
+		if output := CSSIDSelector(input); !checker.IsValidCss3IdValue(output) {
+			t.Errorf("CSSIDSelector(%q) is %q, which is not a valid CSS 3 ID value.", input, output)
+		}
+		if output := CSSClassSelector(input); !checker.IsValidCss3Identifier(output[1:]) {
+			t.Errorf("CSSClassSelector(%q) is %q, which is not a valid CSS 3 class selector.", input, output)
+		}
+	}
+}
+
+func ExampleCSSIDSelector() {
+	fmt.Println(CSSIDSelector("1abc"))
+	fmt.Println(CSSClassSelector("col-md-6.5"))
+	// Output:
+	// #\31 abc
+	// .col-md-6\.5
+}
+
+// BenchmarkEscapeCSSIdentifier  10000000         173   ns/op         0 B/op        0 allocs/op
+func BenchmarkEscapeCSSIdentifier(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = EscapeCSSIdentifier("product-listing_2024")
+	}
+}
@@ -0,0 +1,394 @@
+package escaper
+
+import (
+	"github.com/Dancapistan/htmlutil/checker"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ReferenceStyle is the kind of character reference an Escaper writes.
+//
+type ReferenceStyle int
+
+const (
+	// DefaultReferences are the ones the package functions write: &amp;,
+	// &lt;, and &gt;, and numeric references like &#34; for everything else.
+	DefaultReferences ReferenceStyle = iota
+
+	// NamedReferences are named references, like &quot; and &apos;, where
+	// there is a name, and decimal references otherwise. The names are the
+	// ones checker.NamedReferenceFor prefers, so with ASCIIOnly, U+00A0 is
+	// written as &nbsp;.
+	NamedReferences
+
+	// DecimalReferences are decimal references, like &#34;.
+	DecimalReferences
+
+	// HexReferences are hexadecimal references, like &#x22; and &#x3c;.
+	HexReferences
+)
+
+// An Escaper escapes text for the different parts of an HTML document, like
+// the package functions do, with options for the house style of the output.
+// The zero Escaper gives the same results as the package functions.
+//
+// The options only apply where character references are decoded: text,
+// escapable raw text, and attribute values. Script and style content,
+// comments, and CDATA sections have no character references, so the methods
+// for them are the package functions, and are there so that an Escaper can
+// stand in for the package everywhere.
+//
+type Escaper struct {
+	// AllAmpersands escapes every ampersand, instead of only ambiguous
+	// ampersands and legacy character references without a semicolon. With
+	// it, the output shows character references like "&copy;" literally.
+	AllAmpersands bool
+
+	// References is the kind of character reference written for escaped
+	// characters.
+	References ReferenceStyle
+
+	// ASCIIOnly writes every non-ASCII character as a numeric character
+	// reference, so the output is plain ASCII. Invalid UTF-8 is written as a
+	// reference to U+FFFD. The C1 controls, U+0080 to U+009F, are left as
+	// they are, because the parser reads most of their references, like
+	// &#128;, as Windows-1252, so &#128; is "€".
+	ASCIIOnly bool
+
+	// Idempotent leaves every character reference that the parser decodes
+	// alone, even with AllAmpersands, so escaping the output again doesn't
+	// change it. That includes invalid numeric references, like "&#0;", which
+	// decodes to U+FFFD. Without AllAmpersands, references with a semicolon
+	// are left alone anyway.
+	Idempotent bool
+}
+
+// EscapeText is like the package function EscapeText, or EscapeTextStrict
+// with AllAmpersands.
+//
+func (e Escaper) EscapeText(s string) string {
+	return e.escape(s, textReplacements.chars)
+}
+
+// EscapeAttributeValueDoubleQuoted is like the package function
+// EscapeAttributeValueDoubleQuoted.
+//
+func (e Escaper) EscapeAttributeValueDoubleQuoted(val string) string {
+	return e.escape(val, doubleQuotedReplacements.chars)
+}
+
+// EscapeAttributeValueSingleQuoted is like the package function
+// EscapeAttributeValueSingleQuoted.
+//
+func (e Escaper) EscapeAttributeValueSingleQuoted(val string) string {
+	return e.escape(val, singleQuotedReplacements.chars)
+}
+
+// EscapeAttributeValueUnquoted is like the package function
+// EscapeAttributeValueUnquoted.
+//
+func (e Escaper) EscapeAttributeValueUnquoted(val string) string {
+	return e.escape(val, unquotedReplacements.chars)
+}
+
+// EscapeEscapableRawText is like the package function EscapeEscapableRawText.
+//
+func (e Escaper) EscapeEscapableRawText(tag, text string) string {
+	return e.escapeEscapableRawText(tag, false, text)
+}
+
+// escapeEscapableRawText implements EscapeEscapableRawText, and Escape for
+// RCDATA if anyTag is true. See escapeRCDATA.
+//
+func (e Escaper) escapeEscapableRawText(tag string, anyTag bool, text string) string {
+
+	if !dropsLeadingNewline(tag, anyTag, text) && indexEscapableEndTag(text, tag, anyTag) == -1 &&
+		!e.needsEscaping(text, "") {
+		return text
+	}
+
+	dst := make([]byte, 0, escapedCapacity(text)+1)
+	if dropsLeadingNewline(tag, anyTag, text) {
+		dst = append(dst, '\n')
+	}
+
+	for {
+		i := indexEscapableEndTag(text, tag, anyTag)
+		if i == -1 {
+			return bytesToString(e.appendEscaped(dst, text, ""))
+		}
+		dst = e.appendEscaped(dst, text[:i], "")
+		dst = append(dst, e.reference('<')...)
+		text = text[i+1:]
+	}
+}
+
+// EscapeURLAttribute is like the package function EscapeURLAttribute, and
+// checks the policy the same way. The URL is percent-encoded first, so only
+// its ampersands are left for the options to apply to.
+//
+func (e Escaper) EscapeURLAttribute(val string, policy URLPolicy) string {
+
+	var buf [128]byte
+	url := bytesToString(appendNormalizedURL(buf[:0], val))
+	if !policy.allowsValue(url) {
+		return InvalidURL
+	}
+	return e.escape(url, doubleQuotedReplacements.chars)
+}
+
+// EscapeJSStringInAttribute is like the package function
+// EscapeJSStringInAttribute.
+//
+func (e Escaper) EscapeJSStringInAttribute(val string, quote byte) string {
+	set := attributeReplacements(quote)
+	return e.escape(bytesToString(appendJSString(nil, val)), set.chars)
+}
+
+// EscapeJSONForAttribute is like the package function EscapeJSONForAttribute.
+//
+func (e Escaper) EscapeJSONForAttribute(v any, quote byte) (string, error) {
+	set := attributeReplacements(quote)
+	js, err := marshalHTMLSafeJSON(v)
+	if err != nil {
+		return "", err
+	}
+	return e.escape(bytesToString(js), set.chars), nil
+}
+
+// EscapeScriptContent is the package function EscapeScriptContent.
+//
+func (e Escaper) EscapeScriptContent(s string) string {
+	return EscapeScriptContent(s)
+}
+
+// EscapeStyleContent is the package function EscapeStyleContent.
+//
+func (e Escaper) EscapeStyleContent(s string) string {
+	return EscapeStyleContent(s)
+}
+
+// EscapeCommentText is the package function EscapeCommentText.
+//
+func (e Escaper) EscapeCommentText(text string) string {
+	return EscapeCommentText(text)
+}
+
+// WrapCDATA is the package function WrapCDATA.
+//
+func (e Escaper) WrapCDATA(s string) string {
+	return WrapCDATA(s)
+}
+
+// escape returns s with its ampersands and the characters in chars escaped
+// according to the options.
+//
+func (e Escaper) escape(s, chars string) string {
+	if !e.needsEscaping(s, chars) {
+		return s
+	}
+	return bytesToString(e.appendEscaped(make([]byte, 0, escapedCapacity(s)), s, chars))
+}
+
+// needsEscaping returns true if appendEscaped might change s.
+//
+func (e Escaper) needsEscaping(s, chars string) bool {
+
+	if strings.IndexAny(s, chars) != -1 {
+		return true
+	}
+
+	if e.ASCIIOnly {
+		for i := 0; i < len(s); i++ {
+			if s[i] >= utf8.RuneSelf {
+				return true
+			}
+		}
+	}
+
+	if e.AllAmpersands {
+		return strings.IndexByte(s, unicodeAmpersand) != -1
+	}
+	return hasUnsafeAmpersand(s)
+}
+
+// appendEscaped appends s to dst, with its ampersands and the characters in
+// chars escaped according to the options.
+//
+func (e Escaper) appendEscaped(dst []byte, s string, chars string) []byte {
+
+	var src int // Current read location relative to s.
+
+	if !e.AllAmpersands {
+
+		// The same ampersands as appendEscapedAttributeValue.
+
+		for {
+			j := indexUnsafeAmpersand(s[src:])
+			if j == -1 {
+				return e.appendReplaced(dst, s[src:], chars)
+			}
+			index := src + j
+			dst = e.appendReplaced(dst, s[src:index], chars)
+			dst = append(dst, e.reference('&')...)
+			src = index + 1
+		}
+	}
+
+	for {
+		j := strings.IndexByte(s[src:], unicodeAmpersand)
+		if j == -1 {
+			return e.appendReplaced(dst, s[src:], chars)
+		}
+		index := src + j
+		dst = e.appendReplaced(dst, s[src:index], chars)
+
+		if e.Idempotent {
+			if n := completeReferenceLength(s[index:]); n > 0 {
+				dst = append(dst, s[index:index+n]...)
+				src = index + n
+				continue
+			}
+		}
+
+		dst = append(dst, e.reference('&')...)
+		src = index + 1
+	}
+}
+
+// appendReplaced appends s to dst, replacing the characters in chars, and the
+// non-ASCII characters if ASCIIOnly is set, with character references.
+//
+func (e Escaper) appendReplaced(dst []byte, s string, chars string) []byte {
+
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch {
+		case c < utf8.RuneSelf && strings.IndexByte(chars, c) != -1:
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, e.reference(c)...)
+			i++
+			start = i
+		case c >= utf8.RuneSelf && e.ASCIIOnly:
+			r, width := utf8.DecodeRuneInString(s[i:])
+			if isC1Control(r) {
+				i += width
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			dst = e.appendNonASCIIReference(dst, r)
+			i += width
+			start = i
+		default:
+			i++
+		}
+	}
+	return append(dst, s[start:]...)
+}
+
+// isC1Control returns true if r is a C1 control, U+0080 to U+009F, which
+// has no numeric character reference. See Unescape.
+//
+func isC1Control(r rune) bool {
+	return r >= 0x80 && r <= 0x9F
+}
+
+// reference returns the character reference for the ASCII character c in the
+// style of the Escaper.
+//
+func (e Escaper) reference(c byte) string {
+	if e.References < 0 || int(e.References) >= len(referenceTables) {
+		return referenceTables[DefaultReferences][c]
+	}
+	return referenceTables[e.References][c]
+}
+
+// appendNonASCIIReference appends a character reference for the non-ASCII
+// character r to dst: a named reference for NamedReferences, if r has a name,
+// a hexadecimal reference for HexReferences, and a decimal one otherwise.
+//
+func (e Escaper) appendNonASCIIReference(dst []byte, r rune) []byte {
+	if e.References == NamedReferences {
+		if name, ok := checker.NamedReferenceFor(r); ok {
+			dst = append(dst, unicodeAmpersand)
+			dst = append(dst, name...)
+			return append(dst, unicodeSemicolon)
+		}
+	}
+	if e.References == HexReferences {
+		dst = append(dst, "&#x"...)
+		dst = strconv.AppendInt(dst, int64(r), 16)
+	} else {
+		dst = append(dst, "&#"...)
+		dst = strconv.AppendInt(dst, int64(r), 10)
+	}
+	return append(dst, unicodeSemicolon)
+}
+
+// referenceTables has the references for the ASCII characters that any of the
+// contexts escape, in each ReferenceStyle.
+//
+var referenceTables = func() (tables [4][128]string) {
+
+	defaults := unquotedReplacements.refs
+	defaults['&'] = htmlAmp
+
+	named := map[byte]string{
+		'&':  htmlAmp,
+		'"':  "&quot;",
+		'\'': "&apos;",
+		'<':  "&lt;",
+		'>':  "&gt;",
+		'=':  "&equals;",
+		'`':  "&grave;",
+		'\t': "&Tab;",
+		'\n': "&NewLine;",
+	}
+
+	for c, ref := range defaults {
+		if ref == "" {
+			continue
+		}
+		decimal := "&#" + strconv.Itoa(c) + ";"
+		tables[DefaultReferences][c] = ref
+		tables[NamedReferences][c] = decimal
+		if ref, ok := named[byte(c)]; ok {
+			tables[NamedReferences][c] = ref
+		}
+		tables[DecimalReferences][c] = decimal
+		tables[HexReferences][c] = "&#x" + strconv.FormatInt(int64(c), 16) + ";"
+	}
+	return tables
+}()
+
+// completeReferenceLength returns the length of the character reference at
+// the start of s, which starts with an ampersand, or 0 if there isn't one. It
+// counts every reference that the parser decodes: a known name, or a number,
+// with a semicolon. That includes the invalid numeric references, like "&#13;",
+// which Escaper writes for a carriage return.
+//
+func completeReferenceLength(s string) int {
+
+	i := 1
+	if i < len(s) && s[i] == '#' {
+		i++
+		if i < len(s) && (s[i] == 'x' || s[i] == 'X') {
+			i++
+		}
+	}
+	for i < len(s) && isASCIIAlphanumeric(s[i]) {
+		i++
+	}
+
+	if i < len(s) && s[i] == unicodeSemicolon {
+		switch _, problem := checker.IsAnyCharacterReference(s[:i+1]); problem {
+		case checker.NotCharacterReference, checker.UnknownNamedCharacterReference:
+		default:
+			return i + 1
+		}
+	}
+	return 0
+}
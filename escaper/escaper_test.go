@@ -0,0 +1,264 @@
+package escaper
+
+import (
+	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestEscaper_zeroMatchesPackage(t *testing.T) {
+
+	var e Escaper
+
+	fns := map[string][2]func(string) string{
+		"EscapeText":                       {e.EscapeText, EscapeText},
+		"EscapeAttributeValueDoubleQuoted": {e.EscapeAttributeValueDoubleQuoted, EscapeAttributeValueDoubleQuoted},
+		"EscapeAttributeValueSingleQuoted": {e.EscapeAttributeValueSingleQuoted, EscapeAttributeValueSingleQuoted},
+		"EscapeAttributeValueUnquoted":     {e.EscapeAttributeValueUnquoted, EscapeAttributeValueUnquoted},
+		"EscapeEscapableRawText": {
+			func(s string) string { return e.EscapeEscapableRawText("textarea", s) },
+			func(s string) string { return EscapeEscapableRawText("textarea", s) },
+		},
+		"EscapeURLAttribute": {
+			func(s string) string { return e.EscapeURLAttribute(s, DefaultURLPolicy) },
+			func(s string) string { return EscapeURLAttribute(s, DefaultURLPolicy) },
+		},
+		"EscapeJSStringInAttribute": {
+			func(s string) string { return e.EscapeJSStringInAttribute(s, '\'') },
+			func(s string) string { return EscapeJSStringInAttribute(s, '\'') },
+		},
+	}
+
+	for label, fn := range fns {
+		for _, input := range writerTestInputs {
+			if actual, expected := fn[0](input), fn[1](input); actual != expected {
+				t.Errorf("Escaper{}.%s(%q) is %q, but %s gives %q.", label, input, actual, label, expected)
+			}
+		}
+	}
+
+	strict := Escaper{AllAmpersands: true}
+	for _, input := range writerTestInputs {
+		if actual, expected := strict.EscapeText(input), EscapeTextStrict(input); actual != expected {
+			t.Errorf("Escaper{AllAmpersands: true}.EscapeText(%q) is %q, but EscapeTextStrict gives %q.", input, actual, expected)
+		}
+	}
+}
+
+func TestEscaper_References(t *testing.T) {
+
+	input := `"Tom" & 'Jerry' <3 &copy`
+
+	cases := map[ReferenceStyle][2]string{
+		DefaultReferences: {`"Tom" & 'Jerry' &lt;3 &amp;copy`, `&#34;Tom&#34; & 'Jerry' <3 &amp;copy`},
+		NamedReferences:   {`"Tom" & 'Jerry' &lt;3 &amp;copy`, `&quot;Tom&quot; & 'Jerry' <3 &amp;copy`},
+		DecimalReferences: {`"Tom" & 'Jerry' &#60;3 &#38;copy`, `&#34;Tom&#34; & 'Jerry' <3 &#38;copy`},
+		HexReferences:     {`"Tom" & 'Jerry' &#x3c;3 &#x26;copy`, `&#x22;Tom&#x22; & 'Jerry' <3 &#x26;copy`},
+	}
+
+	for style, expected := range cases {
+		e := Escaper{References: style}
+		if actual := e.EscapeText(input); actual != expected[0] {
+			t.Errorf("Escaper{References: %d}.EscapeText(%q) is %q, but expected %q.", style, input, actual, expected[0])
+		}
+		if actual := e.EscapeAttributeValueDoubleQuoted(input); actual != expected[1] {
+			t.Errorf("Escaper{References: %d}.EscapeAttributeValueDoubleQuoted(%q) is %q, but expected %q.", style, input, actual, expected[1])
+		}
+	}
+}
+
+func TestEscaper_referencesAreValid(t *testing.T) {
+	for style := range referenceTables {
+		for c, ref := range referenceTables[style] {
+			if ref == "" {
+				continue
+			}
+			// Like html/template, the package writes a carriage return as
+			// &#13;, which is a parse error, but still decodes to U+000D.
+
+			if ok, _ := checker.IsAnyCharacterReference(ref); !ok && c != '\r' {
+				t.Errorf("The reference for %q in style %d is %q, which is not valid.", c, style, ref)
+			}
+			if decoded := Unescape(ref); decoded != string(rune(c)) {
+				t.Errorf("The reference for %q in style %d is %q, which decodes to %q.", c, style, ref, decoded)
+			}
+		}
+	}
+}
+
+func TestEscaper_ASCIIOnly(t *testing.T) {
+
+	cases := map[string]string{
+		"café ⌘":       "caf&#233; &#8984;",
+		"a\xFFb":       "a&#65533;b",
+		"plain":        "plain",
+		"<b>é</b>":     "&lt;b>&#233;&lt;/b>",
+		"\u0080":       "\u0080",
+		"a\u0085b":     "a\u0085b",
+		"\u009f\u00a0": "\u009f&#160;",
+	}
+	checkTestCases(t, cases, Escaper{ASCIIOnly: true}.EscapeText, "Escaper{ASCIIOnly: true}.EscapeText")
+
+	hex := map[string]string{
+		"café ⌘": "caf&#xe9; &#x2318;",
+	}
+	checkTestCases(t, hex, Escaper{ASCIIOnly: true, References: HexReferences}.EscapeText, "Escaper{ASCIIOnly: true, References: HexReferences}.EscapeText")
+
+	named := map[string]string{
+		"a → b":      "a &rarr; b",
+		"1\u00a0000": "1&nbsp;000",
+		"café ⌘ 😀":   "caf&eacute; &#8984; &#128512;",
+		`"<&>"`:      "&quot;<&amp;>&quot;",
+	}
+	checkTestCases(t, named, Escaper{ASCIIOnly: true, References: NamedReferences, AllAmpersands: true}.EscapeAttributeValueDoubleQuoted,
+		"Escaper{ASCIIOnly: true, References: NamedReferences, AllAmpersands: true}.EscapeAttributeValueDoubleQuoted")
+
+	// The output decodes to the input, even with C1 controls.
+
+	e := Escaper{ASCIIOnly: true, AllAmpersands: true}
+	for _, input := range append([]string{"\u0080\u0081\u008d\u0099\u009f"}, writerTestInputs...) {
+		if output := e.EscapeText(input); utf8.ValidString(input) && Unescape(output) != input {
+			t.Errorf("%+v.EscapeText(%q) is %q, which decodes to %q.", e, input, output, Unescape(output))
+		}
+	}
+}
+
+func TestEscaper_namedReferencesArePreferred(t *testing.T) {
+	for c, ref := range referenceTables[NamedReferences] {
+		name, ok := checker.NamedReferenceFor(rune(c))
+		if ref != "" && ok && ref != "&"+name+";" {
+			t.Errorf("The named reference for %q is %q, but checker.NamedReferenceFor prefers %q.", rune(c), ref, name)
+		}
+	}
+}
+
+func TestEscaper_Idempotent(t *testing.T) {
+
+	e := Escaper{AllAmpersands: true, Idempotent: true}
+
+	cases := map[string]string{
+		"Tom & Jerry":           "Tom &amp; Jerry",
+		"&amp; &copy; &#169;":   "&amp; &copy; &#169;",
+		"&copy 2014":            "&amp;copy 2014",
+		"&notit; &dan; &#0;":    "&amp;notit; &amp;dan; &#0;",
+		"&#x2665; &#xD800; &#;": "&#x2665; &#xD800; &amp;#;",
+		"&#13; &#xFFFF; &#x8G;": "&#13; &#xFFFF; &amp;#x8G;",
+	}
+	checkTestCases(t, cases, e.EscapeText, "Escaper{AllAmpersands: true, Idempotent: true}.EscapeText")
+
+	escapers := []Escaper{
+		e,
+		{Idempotent: true},
+		{},
+		{AllAmpersands: true, Idempotent: true, ASCIIOnly: true, References: NamedReferences},
+		{AllAmpersands: true, Idempotent: true, References: HexReferences},
+	}
+	for _, e := range escapers {
+		for _, input := range writerTestInputs {
+			once := e.EscapeAttributeValueUnquoted(input)
+			if twice := e.EscapeAttributeValueUnquoted(once); twice != once {
+				t.Errorf("%+v escapes %q to %q, and then to %q.", e, input, once, twice)
+			}
+		}
+	}
+}
+
+func TestEscaper_idempotentForEveryRune(t *testing.T) {
+
+	escapers := []Escaper{
+		{AllAmpersands: true, Idempotent: true},
+		{AllAmpersands: true, Idempotent: true, ASCIIOnly: true},
+		{AllAmpersands: true, Idempotent: true, ASCIIOnly: true, References: NamedReferences},
+		{AllAmpersands: true, Idempotent: true, ASCIIOnly: true, References: HexReferences},
+	}
+
+	for _, e := range escapers {
+		for r := rune(0); r <= utf8.MaxRune; r++ {
+			input := string(r)
+			once := e.EscapeAttributeValueUnquoted(input)
+			if twice := e.EscapeAttributeValueUnquoted(once); twice != once {
+				t.Fatalf("%+v escapes %q to %q, and then to %q.", e, input, once, twice)
+			}
+		}
+	}
+}
+
+func TestEscaper_EscapeURLAttribute(t *testing.T) {
+
+	inputs := []string{
+		"javascript:alert(1)",
+		"javascript&#58;alert(1)",
+		"javascript&colon;alert(1)",
+		"&#106;avascript:alert(1)",
+		"  JAVASCRIPT&#x3A;alert(1)",
+	}
+
+	escapers := []Escaper{
+		{},
+		{AllAmpersands: true},
+		{AllAmpersands: true, Idempotent: true},
+		{ASCIIOnly: true, References: HexReferences},
+	}
+
+	for _, e := range escapers {
+		for _, input := range inputs {
+			if output := e.EscapeURLAttribute(input, DefaultURLPolicy); output != InvalidURL {
+				t.Errorf("%+v.EscapeURLAttribute(%q) is %q, but expected %q.", e, input, output, InvalidURL)
+			}
+		}
+	}
+}
+
+func TestEscaper_valid(t *testing.T) {
+
+	var escapers []Escaper
+	for style := DefaultReferences; style <= HexReferences; style++ {
+		for _, all := range []bool{false, true} {
+			for _, ascii := range []bool{false, true} {
+				for _, idempotent := range []bool{false, true} {
+					escapers = append(escapers, Escaper{AllAmpersands: all, References: style, ASCIIOnly: ascii, Idempotent: idempotent})
+				}
+			}
+		}
+	}
+
+	for _, e := range escapers {
+		for _, input := range writerTestInputs {
+
+			if output := e.EscapeAttributeValueDoubleQuoted(input); !checker.IsValidAttributeValueDoubleQuoted(output) {
+				t.Errorf("%+v.EscapeAttributeValueDoubleQuoted(%q) is %q, which is not valid.", e, input, output)
+			}
+			if output := e.EscapeAttributeValueSingleQuoted(input); !checker.IsValidAttributeValueSingleQuoted(output) {
+				t.Errorf("%+v.EscapeAttributeValueSingleQuoted(%q) is %q, which is not valid.", e, input, output)
+			}
+			if output := e.EscapeEscapableRawText("title", input); !checker.IsValidEscapableRawText("title", output) {
+				t.Errorf("%+v.EscapeEscapableRawText(%q) is %q, which is not valid.", e, input, output)
+			}
+
+			// With every ampersand escaped, the output decodes to the input.
+
+			if e.AllAmpersands && !e.Idempotent && utf8.ValidString(input) {
+				if output := e.EscapeText(input); Unescape(output) != input {
+					t.Errorf("%+v.EscapeText(%q) is %q, which decodes to %q.", e, input, output, Unescape(output))
+				}
+			}
+		}
+	}
+}
+
+func ExampleEscaper() {
+	e := Escaper{AllAmpersands: true, References: NamedReferences, ASCIIOnly: true, Idempotent: true}
+	fmt.Println(e.EscapeAttributeValueDoubleQuoted(`"Café" & Bar &copy; 2024`))
+	// Output:
+	// &quot;Caf&eacute;&quot; &amp; Bar &copy; 2024
+}
+
+// BenchmarkEscaper_EscapeAttributeValueDoubleQuoted   5000000         223 ns/op        48 B/op        1 allocs/op
+func BenchmarkEscaper_EscapeAttributeValueDoubleQuoted(b *testing.B) {
+	e := Escaper{AllAmpersands: true, References: NamedReferences}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = e.EscapeAttributeValueDoubleQuoted(`Tom & "Jerry" &amp; friends`)
+	}
+}
@@ -1,187 +1,375 @@
 package escaper
 
 import (
-	"bytes"
 	"github.com/Dancapistan/htmlutil/checker"
+	"github.com/Dancapistan/htmlutil/internal/charref"
 	"strings"
 )
 
 // Common HTML entities. Stolen from template/funcs.go.
 const (
 	htmlQuot = "&#34;"
+	htmlApos = "&#39;"
 	htmlAmp  = "&amp;"
 )
 
 const (
 	unicodeDoubleQuote = '\u0022'
 	unicodeAmpersand   = '\u0026'
+	unicodeApostrophe  = '\u0027'
 	unicodeSemicolon   = '\u003B'
 )
 
-const doubleQuoteStr = string(unicodeDoubleQuote)
-
-var doubleQuoteByte = []byte(doubleQuoteStr)
-var htmlQuotByte = []byte(htmlQuot)
-
 // EscapeAttributeValueDoubleQuoted returns the argument with double quotes
-// escaped and with ambiguous ampersands escaped.
+// escaped and with ambiguous ampersands escaped. Legacy character references
+// without a semicolon are escaped, too. See EscapeAmbiguousAmpersands.
 //
 func EscapeAttributeValueDoubleQuoted(val string) string {
+	if b := escapeAttributeValue(val, doubleQuotedReplacements); b != nil {
+		return bytesToString(b)
+	}
+	return val
+}
 
-	idxAmp := strings.IndexRune(val, unicodeAmpersand)
-	idxQuo := strings.IndexRune(val, unicodeDoubleQuote)
+// AppendAttributeValueDoubleQuoted appends the argument, escaped as by
+// EscapeAttributeValueDoubleQuoted, to dst and returns the extended buffer. It
+// doesn't allocate if dst has enough capacity.
+//
+func AppendAttributeValueDoubleQuoted(dst []byte, val string) []byte {
+	return appendEscapedAttributeValue(dst, val, doubleQuotedReplacements)
+}
 
-	// Heuristic: If the argument doesn't contain a quote, or an ampersand, then
-	// it is most likely fine unescaped.
+// EscapeAttributeValueSingleQuoted returns the argument with single quotes
+// escaped as &#39; and with ambiguous ampersands escaped, so that it can be
+// used as a single-quoted attribute value. Legacy character references without
+// a semicolon are escaped, too. See EscapeAmbiguousAmpersands.
+//
+// Double quotes are left alone, which makes single quotes a good fit for values
+// with many double quotes, like JSON.
+//
+func EscapeAttributeValueSingleQuoted(val string) string {
+	if b := escapeAttributeValue(val, singleQuotedReplacements); b != nil {
+		return bytesToString(b)
+	}
+	return val
+}
 
-	if idxAmp == -1 && idxQuo == -1 {
+// AppendAttributeValueSingleQuoted appends the argument, escaped as by
+// EscapeAttributeValueSingleQuoted, to dst and returns the extended buffer. It
+// doesn't allocate if dst has enough capacity.
+//
+func AppendAttributeValueSingleQuoted(dst []byte, val string) []byte {
+	return appendEscapedAttributeValue(dst, val, singleQuotedReplacements)
+}
+
+// EscapeAttributeValueUnquoted returns the argument escaped for use as an
+// unquoted attribute value, like "email" in <input type=email>. The space
+// characters and the characters in InvalidAttributeValueUnquotedCharacters
+// are replaced by character references, and ambiguous ampersands and legacy
+// character references are escaped. See EscapeAmbiguousAmpersands.
+//
+// An unquoted attribute value must not be empty, so the result is only valid
+// for a non-empty argument. Use QuoteAttributeValue to handle any value.
+//
+// See checker.IsValidAttributeValueUnquoted.
+//
+func EscapeAttributeValueUnquoted(val string) string {
+	if b := escapeAttributeValue(val, unquotedReplacements); b != nil {
+		return bytesToString(b)
+	}
+	return val
+}
+
+// AppendAttributeValueUnquoted appends the argument, escaped as by
+// EscapeAttributeValueUnquoted, to dst and returns the extended buffer. It
+// doesn't allocate if dst has enough capacity.
+//
+func AppendAttributeValueUnquoted(dst []byte, val string) []byte {
+	return appendEscapedAttributeValue(dst, val, unquotedReplacements)
+}
+
+// QuoteAttributeValue returns the shortest safe way to write the argument as
+// an attribute value: unquoted, double-quoted, or single-quoted, with any
+// escaping that needs. The result includes the quotes, if any.
+//
+// A value that is valid unquoted, like "email", is returned as-is. Otherwise,
+// the shortest result wins, preferring unquoted and then double quotes when
+// there's a tie. So `say "hi"` becomes `'say "hi"'`, and "" becomes `""`.
+//
+func QuoteAttributeValue(val string) string {
+	if val != "" && isSafeUnquoted(val) {
 		return val
 	}
+	b := AppendQuotedAttributeValue(make([]byte, 0, escapedCapacity(val)+2), val)
+	return bytesToString(b)
+}
 
-	var b []byte
+// AppendQuotedAttributeValue appends the argument, quoted as by
+// QuoteAttributeValue, to dst and returns the extended buffer. It doesn't
+// allocate if dst has enough capacity.
+//
+func AppendQuotedAttributeValue(dst []byte, val string) []byte {
 
-	// If we have an ampersand, it *may* contain an ambiguous ampersand that
-	// needs escaping. An ambiguous ampersand is of the form &xxx; (where xxx is
-	// one or more alphanumeric characters). Therefore, we also need a semicolon
-	// to appear after the ampersand for it to be a possible ambiguous
-	// ampersand.
+	if val != "" && isSafeUnquoted(val) {
+		return append(dst, val...)
+	}
 
-	idxSemi := strings.IndexRune(val, unicodeSemicolon)
-	if idxAmp != -1 && idxAmp < idxSemi {
-		b = escapeAmbiguousAmpersandsBuffer(val)
+	unquoted := escapedLength(val, unquotedReplacements)
+	double := escapedLength(val, doubleQuotedReplacements) + 2
+	single := escapedLength(val, singleQuotedReplacements) + 2
+
+	switch {
+	case val != "" && unquoted <= double && unquoted <= single:
+		return appendEscapedAttributeValue(dst, val, unquotedReplacements)
+	case double <= single:
+		dst = append(dst, unicodeDoubleQuote)
+		dst = appendEscapedAttributeValue(dst, val, doubleQuotedReplacements)
+		return append(dst, unicodeDoubleQuote)
+	default:
+		dst = append(dst, unicodeApostrophe)
+		dst = appendEscapedAttributeValue(dst, val, singleQuotedReplacements)
+		return append(dst, unicodeApostrophe)
 	}
+}
 
-	// Escape double quotes characters.
+// isSafeUnquoted returns true if the argument can be used as an unquoted
+// attribute value without any escaping.
+//
+func isSafeUnquoted(val string) bool {
 
-	if idxQuo != -1 {
+	// A legacy reference like "&copy" doesn't make a value invalid, but a
+	// browser would decode it.
 
-		if b == nil {
-			return strings.Replace(val, doubleQuoteStr, htmlQuot, -1)
-		} else {
-			b := bytes.Replace(b, doubleQuoteByte, htmlQuotByte, -1)
-			return string(b)
-		}
+	return checker.IsValidAttributeValueUnquoted(val) &&
+		!checker.HasLegacyCharacterReference(val)
+}
+
+// escapedLength returns the length of the argument once escaped by
+// appendEscapedAttributeValue.
+//
+func escapedLength(val string, set *replacementSet) int {
 
-	} else {
+	length := len(val)
 
-		if b == nil {
-			return val
-		} else {
-			return string(b)
+	for i := 0; i < len(val); {
+		j := strings.IndexAny(val[i:], set.chars)
+		if j == -1 {
+			break
 		}
+		i += j
+		length += len(set.refs[val[i]]) - 1
+		i++
+	}
+
+	for i := 0; ; i++ {
+		j := indexUnsafeAmpersand(val[i:])
+		if j == -1 {
+			return length
+		}
+		i += j
+		length += len(htmlAmp) - 1
 	}
 }
 
+// escapeAttributeValue implements EscapeAttributeValueDoubleQuoted,
+// EscapeAttributeValueSingleQuoted, EscapeAttributeValueUnquoted, and their
+// []byte versions. It returns nil if nothing needs to be escaped.
+//
+func escapeAttributeValue(val string, set *replacementSet) []byte {
+
+	// Heuristic: If the argument doesn't contain a quote, or an ampersand, then
+	// it is most likely fine unescaped.
+
+	if strings.IndexAny(val, set.chars) == -1 && !hasUnsafeAmpersand(val) {
+		return nil
+	}
+
+	return appendEscapedAttributeValue(make([]byte, 0, escapedCapacity(val)), val, set)
+}
+
 // EscapeAmbiguousAmpersands returns a copy of the argument with ambiguous
 // ampersands escaped with &amp;.
 //
+// The ampersands of character references that are missing their semicolon,
+// like the legacy "&copy 2014" or the numeric "&#169 2014", are escaped as
+// well, so that a browser won't decode them. The ampersands of valid character references, like "&copy;",
+// are left alone.
+//
 func EscapeAmbiguousAmpersands(val string) string {
-
-	length := len(val)
-	if length < 3 {
-		return val
+	if b := escapeAmbiguousAmpersandsBuffer(val); b != nil {
+		return bytesToString(b)
 	}
+	return val
+}
 
-	ampIdx := strings.IndexRune(val, unicodeAmpersand)
+// AppendAmbiguousAmpersands appends the argument, escaped as by
+// EscapeAmbiguousAmpersands, to dst and returns the extended buffer. It
+// doesn't allocate if dst has enough capacity.
+//
+func AppendAmbiguousAmpersands(dst []byte, val string) []byte {
+	return appendEscapedAttributeValue(dst, val, nil)
+}
 
-	// No ampersands? Nothing to do.
-	if ampIdx == -1 || ampIdx >= length-2 {
-		return val
-	}
+// escapeAmbiguousAmpersandsBuffer implements EscapeAmbiguousAmpersands and
+// EscapeAmbiguousAmpersandsBytes. It returns nil if nothing needs to be
+// escaped.
+//
+func escapeAmbiguousAmpersandsBuffer(val string) []byte {
 
-	b := escapeAmbiguousAmpersandsBuffer(val)
-	if b != nil {
-		return string(b)
+	if !hasUnsafeAmpersand(val) {
+		return nil
 	}
 
-	return val
+	return appendEscapedAttributeValue(make([]byte, 0, escapedCapacity(val)), val, nil)
 }
 
-func escapeAmbiguousAmpersandsBuffer(val string) []byte {
+// escapedCapacity is a guess at the length of val once escaped. It leaves room
+// for a handful of escapes, which covers most values without growing.
+//
+func escapedCapacity(val string) int {
+	return len(val) + 4*len(htmlAmp)
+}
 
-	var scanner = checker.NamedReferenceScanner{val, -1}
+// hasUnsafeAmpersand returns true if the argument has an ampersand that
+// appendEscapedAttributeValue would escape. See isUnsafeAmpersand.
+//
+func hasUnsafeAmpersand(val string) bool {
+	return indexUnsafeAmpersand(val) != -1
+}
 
-	// Count how many ambiguous ampersands are actually in the string.
+// appendEscapedAttributeValue appends val to dst, escaping its ambiguous
+// ampersands and the ampersands of character references without a semicolon.
+// If set isn't nil, its characters are replaced, too.
+//
+func appendEscapedAttributeValue(dst []byte, val string, set *replacementSet) []byte {
+
+	var src int // Current read location relative to val.
 
-	var count int
-	// var indexes [5]int // cache first 5 ambiguous ampersand indexes
 	for {
-		name, index := scanner.Next()
-		if index == -1 {
-			break
-		}
-		if !checker.IsCharacterReferenceName(name) {
-			// if count < len(indexes) {
-			// indexes[count] = index
-			// }
-			count++
-		}
-	}
-	scanner.Reset()
+		j := indexUnsafeAmpersand(val[src:])
 
-	// If there are no ambiguous ampersands, there is nothing to be escaped.
+		// If we're past the last ampersand to escape, then copy in the
+		// remaining data from `val`.
 
-	if count == 0 {
-		return nil
-	}
+		if j == -1 {
+			return appendReplaced(dst, val[src:], set)
+		}
 
-	// We have at least one ambiguous ampersand to be escaped. Calculate the
-	// final width.
-	//
-	finalLength := len(val) - count + (count * len(htmlAmp))
+		// Copy in the data from `val` from where we left off up to but not
+		// including the ampersand. Then copy in the escaped version of the
+		// ampersand.
 
-	// Create the bytes buffer that we'll copy the final escaped string into.
+		index := src + j
+		dst = appendReplaced(dst, val[src:index], set)
+		dst = append(dst, htmlAmp...)
+		src = index + 1 // skip the ampersand.
+	}
+}
 
-	var b = make([]byte, finalLength)
-	var dest int // Current write location relative to b.
-	var src int  // Current read location relative to val.
+// indexUnsafeAmpersand returns the index of the first ampersand in val that
+// isUnsafeAmpersand is true for, or -1 if there isn't one.
+//
+func indexUnsafeAmpersand(val string) int {
+	for i := 0; ; i++ {
+		j := strings.IndexByte(val[i:], unicodeAmpersand)
+		if j == -1 {
+			return -1
+		}
+		i += j
+		if isUnsafeAmpersand(val[i:]) {
+			return i
+		}
+	}
+}
 
-	// We cached the first len(indexes) of ambiguous ampersands. Escape those
-	// first.
+// isUnsafeAmpersand returns true if the ampersand at the start of s has to be
+// escaped, because it is ambiguous, like "&tuesday;", or because it starts a
+// character reference without a semicolon, which a browser may decode, like
+// "&copy 2014" or "&#169 2014".
+//
+// The same named references are escaped as with NamedReferenceScanner's
+// NextLegacy, and the same numeric references as Unescape decodes.
+//
+func isUnsafeAmpersand(s string) bool {
 
-	// var numCached = count
-	// if count >= len(indexes) {
-	// 	numCached = len(indexes)
-	// }
+	if strings.HasPrefix(s, "&#") {
+		_, n, semicolon := charref.ParseNumeric(s)
+		return n > 0 && !semicolon
+	}
 
-	// for i := 0; i < numCached; i++ {
-	// 	ampIndex := indexes[i]
-	// 	dest += copy(b[dest:], val[src:ampIndex])
-	// 	dest += copy(b[dest:], htmlAmp)
-	// 	src = ampIndex + 1
-	// }
+	s = s[1:]
+	n := 0
+	for n < len(s) && isASCIIAlphanumeric(s[n]) {
+		n++
+	}
+	if n == 0 {
+		return false
+	}
+	name := s[:n]
+	terminated := strings.HasPrefix(s[n:], ";")
+
+	switch {
+	case terminated && checker.IsCharacterReferenceName(name):
+		return false
+	case checker.LegacyCharacterReferencePrefix(name) != "":
+		return true
+	}
+	return terminated
+}
 
-	// if numCached == count {
-	// 	copy(b[dest:], val[src:])
-	// 	return b
-	// }
+// A replacementSet lists the characters that have to be replaced by character
+// references in some context, like the double quote in a double-quoted
+// attribute value.
+//
+type replacementSet struct {
+	chars string      // The characters to replace, all ASCII.
+	refs  [128]string // The reference for each of the characters.
+}
 
-	// If we get here, then the input has even more ambiguous ampersands than we
-	// cached, so we need to re-scan for those and escape them, too.
+var doubleQuotedReplacements = &replacementSet{
+	chars: `"`,
+	refs:  [128]string{'"': htmlQuot},
+}
 
-	// scanner.LastIndex = indexes[len(indexes)-1] + 1
-	for {
-		name, index := scanner.Next()
+var singleQuotedReplacements = &replacementSet{
+	chars: `'`,
+	refs:  [128]string{'\'': htmlApos},
+}
 
-		// If we're past the last possible ambiguous ampersand, then copy in the
-		// remaining data from `val`.
+// The references are the ones html/template uses for unquoted values.
+var unquotedReplacements = &replacementSet{
+	chars: checker.SpaceCharacters + checker.InvalidAttributeValueUnquotedCharacters,
+	refs: [128]string{
+		' ':  "&#32;",
+		'\t': "&#9;",
+		'\n': "&#10;",
+		'\f': "&#12;",
+		'\r': "&#13;",
+		'"':  htmlQuot,
+		'\'': htmlApos,
+		'<':  "&lt;",
+		'=':  "&#61;",
+		'>':  "&gt;",
+		'`':  "&#96;",
+	},
+}
 
-		if index == -1 {
-			copy(b[dest:], val[src:])
-			break
-		}
+// appendReplaced appends s to dst, replacing each character of the set with
+// its character reference. If set is nil, s is appended as-is.
+//
+func appendReplaced(dst []byte, s string, set *replacementSet) []byte {
 
-		// If we're at an ambiguous ampersand (i.e. if name is not a valid
-		// character reference), then copy in the data from `val` from where we
-		// left off up to but not including the ampersand. Then copy in the
-		// escaped version of the ampersand.
+	if set == nil {
+		return append(dst, s...)
+	}
 
-		if !checker.IsCharacterReferenceName(name) {
-			dest += copy(b[dest:], val[src:index])
-			dest += copy(b[dest:], htmlAmp)
-			src = index + 1 // skip the ampersand.
+	for {
+		i := strings.IndexAny(s, set.chars)
+		if i == -1 {
+			return append(dst, s...)
 		}
+		dst = append(dst, s[:i]...)
+		dst = append(dst, set.refs[s[i]]...)
+		s = s[i+1:]
 	}
-
-	return b
 }
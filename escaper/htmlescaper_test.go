@@ -2,6 +2,7 @@ package escaper
 
 import (
 	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
 	"testing"
 )
 
@@ -18,6 +19,15 @@ func TestEscapeAmbiguousAmpersands(t *testing.T) {
 		"this &could; be &ambigous;.":          "this &amp;could; be &amp;ambigous;.",
 		"no &amp; here":                        "no &amp; here",
 		"test &a;&b;&c;&d;&e;&f;&g;&h; \u2318": "test &amp;a;&amp;b;&amp;c;&amp;d;&amp;e;&amp;f;&amp;g;&amp;h; \u2318",
+		"&copy 2014":                           "&amp;copy 2014",
+		"&copy; 2014":                          "&copy; 2014",
+		"&notit;":                              "&amp;notit;",
+		"&notin;":                              "&notin;",
+		"&lt":                                  "&amp;lt",
+		"a &copy &amp; &lt":                    "a &amp;copy &amp; &amp;lt",
+		"&#169 2014":                           "&amp;#169 2014",
+		"&#x41":                                "&amp;#x41",
+		"&#X41; &#65;":                         "&#X41; &#65;",
 	}
 	checkTestCases(t, cases, EscapeAmbiguousAmpersands,
 		"EscapeAmbiguousAmpersands")
@@ -26,6 +36,7 @@ func TestEscapeAmbiguousAmpersands(t *testing.T) {
 // BenchmarkEscapeAmbiguousAmpersands_simple 10000000         106   ns/op         0 B/op        0 allocs/op
 // BenchmarkEscapeAmbiguousAmpersands_simple 20000000          84.8 ns/op         0 B/op        0 allocs/op # Using scanner
 // BenchmarkEscapeAmbiguousAmpersands_simple 20000000         112   ns/op         0 B/op        0 allocs/op # Switched from buffer to []byte
+// BenchmarkEscapeAmbiguousAmpersands_simple 20000000          56.2 ns/op         0 B/op        0 allocs/op # single pass, append
 func BenchmarkEscapeAmbiguousAmpersands_simple(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
@@ -39,6 +50,7 @@ func BenchmarkEscapeAmbiguousAmpersands_simple(b *testing.B) {
 // BenchmarkEscapeAmbiguousAmpersands_complex   1000000        1118 ns/op        98 B/op        2 allocs/op # Switched from buffer to []byte
 // BenchmarkEscapeAmbiguousAmpersands_complex   2000000         878 ns/op        98 B/op        2 allocs/op # cache amp indexes
 // BenchmarkEscapeAmbiguousAmpersands_complex   5000000         661 ns/op        96 B/op        2 allocs/op # Go 1.2
+// BenchmarkEscapeAmbiguousAmpersands_complex   5000000         263 ns/op        48 B/op        1 allocs/op # single pass, append
 func BenchmarkEscapeAmbiguousAmpersands_complex(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
@@ -64,6 +76,9 @@ func TestEscapeAttributeValueDoubleQuoted(t *testing.T) {
 		"&dan;":        "&amp;dan;",              // ambiguous ampersand
 		"&dan;\"xxx\"": "&amp;dan;&#34;xxx&#34;", // ambiguous ampersand and double quote
 		"\"\u2318\"":   "&#34;\u2318&#34;",       // double quotes
+		"&copy 2014":   "&amp;copy 2014",         // legacy character reference
+		"&#169 2014":   "&amp;#169 2014",         // numeric character reference
+		"&#x41\"":      "&amp;#x41&#34;",         // numeric character reference
 	}
 
 	checkTestCases(t, cases, EscapeAttributeValueDoubleQuoted,
@@ -77,7 +92,135 @@ func ExampleEscapeAttributeValueDoubleQuoted() {
 	// title="My name is &#34;Franklin&#34;."
 }
 
-//   BenchmarkEscapeAttributeValueDoubleQuoted_none 20000000         109 ns/op         0 B/op        0 allocs/op
+func TestEscapeAttributeValueSingleQuoted(t *testing.T) {
+
+	cases := map[string]string{
+		"":                 "",
+		"a":                "a",
+		"ok fine":          "ok fine",
+		`"fine"`:           `"fine"`,
+		`{"a": [1, 2]}`:    `{"a": [1, 2]}`,
+		"it's":             "it&#39;s",
+		"&dan;":            "&amp;dan;",              // ambiguous ampersand
+		"&dan;'xxx'":       "&amp;dan;&#39;xxx&#39;", // ambiguous ampersand and single quote
+		"'\u2318'":         "&#39;\u2318&#39;",       // single quotes
+		"&copy 2014":       "&amp;copy 2014",         // legacy character reference
+		`{"a": "&copy;'"}`: `{"a": "&copy;&#39;"}`,
+	}
+
+	checkTestCases(t, cases, EscapeAttributeValueSingleQuoted,
+		"EscapeAttributeValueSingleQuoted")
+}
+
+func TestAppendAttributeValueSingleQuoted(t *testing.T) {
+	checkAppendAgrees(t, bytesTestInputs, AppendAttributeValueSingleQuoted,
+		EscapeAttributeValueSingleQuoted, "AppendAttributeValueSingleQuoted")
+}
+
+func TestEscapedAttributeValuesAreValid(t *testing.T) {
+	for _, input := range writerTestInputs {
+		if output := EscapeAttributeValueDoubleQuoted(input); !checker.IsValidAttributeValueDoubleQuoted(output) {
+			t.Errorf("EscapeAttributeValueDoubleQuoted(%q) is %q, which is not a valid double-quoted value.", input, output)
+		}
+		if output := EscapeAttributeValueSingleQuoted(input); !checker.IsValidAttributeValueSingleQuoted(output) {
+			t.Errorf("EscapeAttributeValueSingleQuoted(%q) is %q, which is not a valid single-quoted value.", input, output)
+		}
+		if output := EscapeAttributeValueUnquoted(input); input != "" && !checker.IsValidAttributeValueUnquoted(output) {
+			t.Errorf("EscapeAttributeValueUnquoted(%q) is %q, which is not a valid unquoted value.", input, output)
+		}
+	}
+}
+
+func TestEscapeAttributeValueUnquoted(t *testing.T) {
+
+	cases := map[string]string{
+		"":           "",
+		"email":      "email",
+		"a b":        "a&#32;b",
+		"a\tb\nc":    "a&#9;b&#10;c",
+		`"x"`:        "&#34;x&#34;",
+		"it's":       "it&#39;s",
+		"<a=b>":      "&lt;a&#61;b&gt;",
+		"`x`":        "&#96;x&#96;",
+		"&dan;":      "&amp;dan;",
+		"&copy 2014": "&amp;copy&#32;2014",
+		"a&amp;b":    "a&amp;b",
+		"/path?q=1":  "/path?q&#61;1",
+	}
+
+	checkTestCases(t, cases, EscapeAttributeValueUnquoted,
+		"EscapeAttributeValueUnquoted")
+}
+
+func TestAppendAttributeValueUnquoted(t *testing.T) {
+	checkAppendAgrees(t, bytesTestInputs, AppendAttributeValueUnquoted,
+		EscapeAttributeValueUnquoted, "AppendAttributeValueUnquoted")
+}
+
+func TestQuoteAttributeValue(t *testing.T) {
+
+	cases := map[string]string{
+		"":            `""`,
+		"email":       "email",
+		"a b":         `"a b"`,
+		`say "hi"`:    `'say "hi"'`,
+		`it's`:        `"it's"`,
+		`it's "x"`:    `'it&#39;s "x"'`,
+		`'a' "b" "c"`: `'&#39;a&#39; "b" "c"'`,
+		"a=b":         `"a=b"`,
+		"&copy":       "&amp;copy",
+		"&copy;":      "&copy;",
+		"&dan;":       "&amp;dan;",
+	}
+
+	checkTestCases(t, cases, QuoteAttributeValue, "QuoteAttributeValue")
+	checkAppendAgrees(t, bytesTestInputs, AppendQuotedAttributeValue,
+		QuoteAttributeValue, "AppendQuotedAttributeValue")
+}
+
+func TestQuoteAttributeValue_roundTrip(t *testing.T) {
+	for _, input := range writerTestInputs {
+		quoted := QuoteAttributeValue(input)
+		val := quoted
+		switch {
+		case len(quoted) >= 2 && quoted[0] == '"':
+			val = quoted[1 : len(quoted)-1]
+			if !checker.IsValidAttributeValueDoubleQuoted(val) {
+				t.Errorf("QuoteAttributeValue(%q) is %s, which is not valid.", input, quoted)
+			}
+		case len(quoted) >= 2 && quoted[0] == '\'':
+			val = quoted[1 : len(quoted)-1]
+			if !checker.IsValidAttributeValueSingleQuoted(val) {
+				t.Errorf("QuoteAttributeValue(%q) is %s, which is not valid.", input, quoted)
+			}
+		default:
+			if !checker.IsValidAttributeValueUnquoted(val) {
+				t.Errorf("QuoteAttributeValue(%q) is %s, which is not valid.", input, quoted)
+			}
+		}
+		if unescaped := UnescapeAttributeValue(val); unescaped != UnescapeAttributeValue(EscapeAttributeValueDoubleQuoted(input)) {
+			t.Errorf("QuoteAttributeValue(%q) is %s, which decodes to %q.", input, quoted, unescaped)
+		}
+	}
+}
+
+func ExampleQuoteAttributeValue() {
+	fmt.Printf("<input type=%s value=%s title=%s>\n",
+		QuoteAttributeValue("email"),
+		QuoteAttributeValue(""),
+		QuoteAttributeValue(`Your "work" email`))
+	// Output:
+	// <input type=email value="" title='Your "work" email'>
+}
+
+func ExampleEscapeAttributeValueSingleQuoted() {
+	data := EscapeAttributeValueSingleQuoted(`{"name": "Franklin's"}`)
+	fmt.Printf("data-person='%s'", data)
+	// Output:
+	// data-person='{"name": "Franklin&#39;s"}'
+}
+
+// BenchmarkEscapeAttributeValueDoubleQuoted_none 20000000         109 ns/op         0 B/op        0 allocs/op
 func BenchmarkEscapeAttributeValueDoubleQuoted_none(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
@@ -87,6 +230,7 @@ func BenchmarkEscapeAttributeValueDoubleQuoted_none(b *testing.B) {
 
 // BenchmarkEscapeAttributeValueDoubleQuoted_quote   5000000        677 ns/op        65 B/op        2 allocs/op
 // BenchmarkEscapeAttributeValueDoubleQuoted_quote  5000000         436 ns/op        64 B/op        2 allocs/op # Go 1.2
+// BenchmarkEscapeAttributeValueDoubleQuoted_quote 10000000         113 ns/op        32 B/op        1 allocs/op # escapeDoubleQuotes
 func BenchmarkEscapeAttributeValueDoubleQuoted_quote(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
@@ -97,6 +241,7 @@ func BenchmarkEscapeAttributeValueDoubleQuoted_quote(b *testing.B) {
 // BenchmarkEscapeAttributeValueDoubleQuoted_both  1000000        1778 ns/op       269 B/op        5 allocs/op
 // BenchmarkEscapeAttributeValueDoubleQuoted_both  1000000        1486 ns/op       130 B/op        3 allocs/op # improved escapeAmbiguousAmpersandsBuffer
 // BenchmarkEscapeAttributeValueDoubleQuoted_both   2000000        955 ns/op       128 B/op        3 allocs/op # Go 1.2
+// BenchmarkEscapeAttributeValueDoubleQuoted_both   5000000        229 ns/op        48 B/op        1 allocs/op # single pass, append
 func BenchmarkEscapeAttributeValueDoubleQuoted_both(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
@@ -114,3 +259,56 @@ func checkTestCases(t *testing.T, cases map[string]string, mutator func(string)
 		}
 	}
 }
+
+func TestAppendAttributeValueDoubleQuoted(t *testing.T) {
+	checkAppendAgrees(t, bytesTestInputs, AppendAttributeValueDoubleQuoted,
+		EscapeAttributeValueDoubleQuoted, "AppendAttributeValueDoubleQuoted")
+}
+
+func TestAppendAmbiguousAmpersands(t *testing.T) {
+	checkAppendAgrees(t, bytesTestInputs, AppendAmbiguousAmpersands,
+		EscapeAmbiguousAmpersands, "AppendAmbiguousAmpersands")
+}
+
+func TestAppendAttributeValueDoubleQuoted_allocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendAttributeValueDoubleQuoted(buf[:0], `An "&ambiguous;" ampersand.`)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected AppendAttributeValueDoubleQuoted not to allocate, but got %v allocs.", allocs)
+	}
+}
+
+func ExampleAppendAttributeValueDoubleQuoted() {
+	b := []byte(`<a title="`)
+	b = AppendAttributeValueDoubleQuoted(b, `Tom & "Jerry"`)
+	b = append(b, `">`...)
+	fmt.Println(string(b))
+	// Output:
+	// <a title="Tom & &#34;Jerry&#34;">
+}
+
+// BenchmarkAppendAttributeValueDoubleQuoted_both 10000000         117 ns/op         0 B/op        0 allocs/op
+func BenchmarkAppendAttributeValueDoubleQuoted_both(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendAttributeValueDoubleQuoted(buf[:0], `An "&ambiguous;" ampersand.`)
+	}
+}
+
+// checkAppendAgrees checks that the append function appends the same thing
+// the escape function returns, and leaves the existing contents of dst alone.
+func checkAppendAgrees(t *testing.T, inputs []string, appendFn func([]byte, string) []byte, escapeFn func(string) string, testLabel string) {
+	const prefix = "prefix&"
+	for _, input := range inputs {
+		expected := prefix + escapeFn(input)
+		output := string(appendFn([]byte(prefix), input))
+		if output != expected {
+			t.Errorf(
+				"Expectation failed. For %s, was expecting %q => %q, but got %q",
+				testLabel, input, expected, output)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package escaper
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EscapeJSStringInAttribute returns the argument escaped for use inside a
+// JavaScript string literal, inside an attribute value, like the "..." in
+// onclick="doThing('...')". The quote argument is the quote character the
+// attribute value is enclosed in, either '"' or '\''. The result works in a JS
+// string enclosed in either kind of quote.
+//
+// The value is escaped in two layers, in the reverse of the order the browser
+// decodes them. First, it is escaped as a JS string: backslashes, quotes, and
+// newlines get a backslash, the line terminators U+2028 and U+2029 become
+// \u2028 and \u2029, and the other control characters, "<", and "&" become
+// \xHH escapes. Then, the result is escaped as an attribute value, like
+// EscapeAttributeValueDoubleQuoted or EscapeAttributeValueSingleQuoted do.
+//
+// Escaping every "&" in the JS layer matters: the browser decodes character
+// references in the attribute before the script sees it, so a value like
+// "&#39;" would otherwise turn into a quote that ends the JS string.
+//
+// The argument is expected to be UTF-8. It panics if quote is not '"' or '\''.
+//
+func EscapeJSStringInAttribute(val string, quote byte) string {
+	if b := escapeJSStringInAttribute(val, attributeReplacements(quote)); b != nil {
+		return bytesToString(b)
+	}
+	return val
+}
+
+// AppendJSStringInAttribute appends the argument, escaped as by
+// EscapeJSStringInAttribute, to dst and returns the extended buffer. It doesn't
+// allocate for short values if dst has enough capacity.
+//
+func AppendJSStringInAttribute(dst []byte, val string, quote byte) []byte {
+	return appendJSStringInAttribute(dst, val, attributeReplacements(quote))
+}
+
+// EscapeJSString returns the argument escaped for use inside a JavaScript
+// string literal, enclosed in either kind of quote, in a <script> element. It
+// is the first layer of EscapeJSStringInAttribute: backslashes, quotes, and
+// newlines get a backslash, and the line terminators, the other control
+// characters, "<", and "&" are written as escapes, so the string can't end the
+// script early.
+//
+func EscapeJSString(s string) string {
+	if b := escapeJSString(s); b != nil {
+		return bytesToString(b)
+	}
+	return s
+}
+
+// AppendJSString appends the argument, escaped as by EscapeJSString, to dst and
+// returns the extended buffer. It doesn't allocate if dst has enough capacity.
+//
+func AppendJSString(dst []byte, s string) []byte {
+	return appendJSString(dst, s)
+}
+
+// escapeJSString implements EscapeJSString and its []byte version. It returns
+// nil if nothing needs to be escaped.
+//
+func escapeJSString(s string) []byte {
+	if _, _, i := nextJSReplacement(s, 0); i == -1 {
+		return nil
+	}
+	return appendJSString(make([]byte, 0, escapedCapacity(s)), s)
+}
+
+// attributeReplacements returns the replacements for an attribute value
+// enclosed in quote. It panics if quote is not '"' or '\''.
+//
+func attributeReplacements(quote byte) *replacementSet {
+	switch quote {
+	case unicodeDoubleQuote:
+		return doubleQuotedReplacements
+	case unicodeApostrophe:
+		return singleQuotedReplacements
+	}
+	panic(fmt.Sprintf("escaper: invalid attribute value quote %q", quote))
+}
+
+// appendJSStringInAttribute implements AppendJSStringInAttribute.
+//
+func appendJSStringInAttribute(dst []byte, val string, set *replacementSet) []byte {
+	var buf [128]byte
+	js := appendJSString(buf[:0], val)
+	return appendEscapedAttributeValue(dst, bytesToString(js), set)
+}
+
+// escapeJSStringInAttribute implements EscapeJSStringInAttribute and its []byte
+// version. It returns nil if nothing needs to be escaped.
+//
+func escapeJSStringInAttribute(val string, set *replacementSet) []byte {
+
+	// The JS layer escapes every quote and ampersand, so if it leaves the value
+	// alone, so does the attribute layer.
+
+	if _, _, i := nextJSReplacement(val, 0); i == -1 {
+		return nil
+	}
+	return appendJSStringInAttribute(make([]byte, 0, escapedCapacity(val)), val, set)
+}
+
+// jsStringReplacements are the escapes for the ASCII characters that can't
+// appear as they are in a JS string literal that is inside an attribute value.
+//
+var jsStringReplacements = func() (refs [128]string) {
+	for c := 0; c < 0x20; c++ {
+		refs[c] = fmt.Sprintf(`\x%02X`, c)
+	}
+	refs[0x7F] = `\x7F`
+	refs['\n'] = `\n`
+	refs['\r'] = `\r`
+	refs['\\'] = `\\`
+	refs['\''] = `\'`
+	refs['"'] = `\"`
+	refs['&'] = `\x26`
+	refs['<'] = `\x3C`
+	return refs
+}()
+
+// appendJSString appends the argument, escaped for a JS string literal, to dst
+// and returns the extended buffer.
+//
+func appendJSString(dst []byte, s string) []byte {
+
+	for i := 0; ; {
+		rep, width, j := nextJSReplacement(s, i)
+		if j == -1 {
+			return append(dst, s[i:]...)
+		}
+		dst = append(dst, s[i:j]...)
+		dst = append(dst, rep...)
+		i = j + width
+	}
+}
+
+// nextJSReplacement finds the first character at or after index i of s that
+// appendJSString escapes, and returns its escape, its width in bytes, and its
+// index. If there isn't one, the index is -1.
+//
+func nextJSReplacement(s string, i int) (rep string, width, index int) {
+
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c < 0x80 {
+			if rep := jsStringReplacements[c]; rep != "" {
+				return rep, 1, i
+			}
+			continue
+		}
+
+		// U+2028 LINE SEPARATOR and U+2029 PARAGRAPH SEPARATOR end a line in
+		// older JS engines, even inside a string.
+
+		if c == 0xE2 {
+			if strings.HasPrefix(s[i:], "\u2028") {
+				return `\u2028`, len("\u2028"), i
+			}
+			if strings.HasPrefix(s[i:], "\u2029") {
+				return `\u2029`, len("\u2029"), i
+			}
+		}
+	}
+	return "", 0, -1
+}
@@ -0,0 +1,87 @@
+package escaper
+
+import (
+	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
+	"testing"
+)
+
+func TestEscapeJSStringInAttribute(t *testing.T) {
+
+	cases := map[string]string{
+		"":                   "",
+		"plain text":         "plain text",
+		"it's":               `it\'s`,
+		`say "hi"`:           `say \&#34;hi\&#34;`,
+		`C:\temp`:            `C:\\temp`,
+		"a\nb\rc":            `a\nb\rc`,
+		"a\tb\x00c\x7F":      `a\x09b\x00c\x7F`,
+		"a\u2028b\u2029c":    `a\u2028b\u2029c`,
+		"</script>":          `\x3C/script>`,
+		"Tom & Jerry":        `Tom \x26 Jerry`,
+		"&#39;);alert(1);//": `\x26#39;);alert(1);//`,
+		"&quot;":             `\x26quot;`,
+		"café ⌘":             "café ⌘",
+		`\'`:                 `\\\'`,
+	}
+
+	checkTestCases(t, cases, func(s string) string { return EscapeJSStringInAttribute(s, '"') }, "EscapeJSStringInAttribute")
+}
+
+func TestEscapeJSStringInAttribute_singleQuoted(t *testing.T) {
+
+	cases := map[string]string{
+		"it's":     `it\&#39;s`,
+		`say "hi"`: `say \"hi\"`,
+	}
+
+	checkTestCases(t, cases, func(s string) string { return EscapeJSStringInAttribute(s, '\'') }, "EscapeJSStringInAttribute")
+}
+
+func TestAppendJSStringInAttribute(t *testing.T) {
+	checkAppendAgrees(t, writerTestInputs,
+		func(dst []byte, s string) []byte { return AppendJSStringInAttribute(dst, s, '"') },
+		func(s string) string { return EscapeJSStringInAttribute(s, '"') },
+		"AppendJSStringInAttribute")
+}
+
+func TestEscapeJSStringInAttribute_valid(t *testing.T) {
+	for _, input := range writerTestInputs {
+		output := EscapeJSStringInAttribute(input, '"')
+		if !checker.IsValidAttributeValueDoubleQuoted(output) {
+			t.Errorf("EscapeJSStringInAttribute(%q) is %q, which is not a valid attribute value.", input, output)
+		}
+		if decoded := UnescapeAttributeValue(output); decoded != jsStringLayer(input) {
+			t.Errorf("EscapeJSStringInAttribute(%q) decodes to %q, but expected %q.", input, decoded, jsStringLayer(input))
+		}
+	}
+}
+
+func TestEscapeJSStringInAttribute_panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("EscapeJSStringInAttribute with quote '`' didn't panic.")
+		}
+	}()
+	EscapeJSStringInAttribute("x", '`')
+}
+
+// jsStringLayer returns just the JS layer of EscapeJSStringInAttribute.
+func jsStringLayer(s string) string {
+	return string(appendJSString(nil, s))
+}
+
+func ExampleEscapeJSStringInAttribute() {
+	name := `O'Brien & "Sons"`
+	fmt.Printf(`<button onclick="greet('%s')">`+"\n", EscapeJSStringInAttribute(name, '"'))
+	// Output:
+	// <button onclick="greet('O\'Brien \x26 \&#34;Sons\&#34;')">
+}
+
+// BenchmarkEscapeJSStringInAttribute   5000000         229 ns/op        48 B/op        1 allocs/op
+func BenchmarkEscapeJSStringInAttribute(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = EscapeJSStringInAttribute(`O'Brien & "Sons"`, '"')
+	}
+}
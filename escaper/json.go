@@ -0,0 +1,60 @@
+package escaper
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// EscapeJSONForScript returns the JSON encoding of v, safe to put inside a
+// <script type="application/json"> element, or any other script element. The
+// characters "<", ">", and "&" are written as \u003c, \u003e, and \u0026, so
+// the content can't contain "</script" or "<!--", and U+2028 and U+2029 are
+// written as \u2028 and \u2029. The result is still valid JSON, and decodes
+// to the same value. See checker.IsValidRawText.
+//
+// If v can't be encoded, the error from json.Marshal is returned.
+//
+func EscapeJSONForScript(v any) ([]byte, error) {
+	return marshalHTMLSafeJSON(v)
+}
+
+// EscapeJSONForAttribute returns the JSON encoding of v, escaped for use as an
+// attribute value enclosed in quote, which is either '"' or '\'', like the
+// value of data-state='{"id":1}'. The JSON is encoded as by
+// EscapeJSONForScript, so it has no ampersands, and then the quote character
+// is escaped as by EscapeAttributeValueDoubleQuoted or
+// EscapeAttributeValueSingleQuoted. Single quotes give the shorter result,
+// because JSON is full of double quotes.
+//
+// If v can't be encoded, the error from json.Marshal is returned. It panics if
+// quote is not '"' or '\''.
+//
+func EscapeJSONForAttribute(v any, quote byte) (string, error) {
+
+	set := attributeReplacements(quote)
+
+	js, err := marshalHTMLSafeJSON(v)
+	if err != nil {
+		return "", err
+	}
+
+	s := bytesToString(js)
+	return bytesToString(appendEscapedAttributeValue(make([]byte, 0, escapedCapacity(s)), s, set)), nil
+}
+
+// marshalHTMLSafeJSON implements EscapeJSONForScript.
+//
+func marshalHTMLSafeJSON(v any) ([]byte, error) {
+
+	// json.Marshal already escapes "<", ">", "&", U+2028, and U+2029, even in
+	// the output of a json.Marshaler, but the encoder is set up explicitly so
+	// that doesn't rest on a default.
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(true)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
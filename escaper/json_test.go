@@ -0,0 +1,93 @@
+package escaper
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// jsonTestValues are encoded by each JSON escaper, and must come back the same.
+var jsonTestValues = []any{
+	nil,
+	42.5,
+	"plain",
+	"</script><script>alert(1)</script>",
+	"<!--<script>",
+	"it's \"quoted\"",
+	"&#39; &amp; &copy",
+	"line\u2028separator\u2029paragraph",
+	[]any{"a", 1.0, true, map[string]any{"b": "</ScRiPt>"}},
+	map[string]any{"user": map[string]any{"name": "O'Brien & Sons", "bio": "<b>hi</b>"}},
+}
+
+func TestEscapeJSONForScript(t *testing.T) {
+	for _, v := range jsonTestValues {
+
+		b, err := EscapeJSONForScript(v)
+		if err != nil {
+			t.Errorf("EscapeJSONForScript(%#v) failed: %v", v, err)
+			continue
+		}
+
+		if !checker.IsValidRawText("script", string(b)) || strings.ContainsAny(string(b), "<>&\u2028\u2029") {
+			t.Errorf("EscapeJSONForScript(%#v) is %s, which is not safe script content.", v, b)
+		}
+
+		var decoded any
+		if err := json.Unmarshal(b, &decoded); err != nil || !reflect.DeepEqual(decoded, v) {
+			t.Errorf("EscapeJSONForScript(%#v) is %s, which decodes to %#v.", v, b, decoded)
+		}
+	}
+}
+
+func TestEscapeJSONForAttribute(t *testing.T) {
+	for _, quote := range []byte{'"', '\''} {
+		for _, v := range jsonTestValues {
+
+			s, err := EscapeJSONForAttribute(v, quote)
+			if err != nil {
+				t.Errorf("EscapeJSONForAttribute(%#v, %q) failed: %v", v, quote, err)
+				continue
+			}
+
+			valid := checker.IsValidAttributeValueDoubleQuoted(s)
+			if quote == '\'' {
+				valid = checker.IsValidAttributeValueSingleQuoted(s)
+			}
+			if !valid {
+				t.Errorf("EscapeJSONForAttribute(%#v, %q) is %s, which is not a valid attribute value.", v, quote, s)
+			}
+
+			var decoded any
+			if err := json.Unmarshal([]byte(UnescapeAttributeValue(s)), &decoded); err != nil || !reflect.DeepEqual(decoded, v) {
+				t.Errorf("EscapeJSONForAttribute(%#v, %q) is %s, which decodes to %#v.", v, quote, s, decoded)
+			}
+		}
+	}
+}
+
+func TestEscapeJSONForScript_error(t *testing.T) {
+	if _, err := EscapeJSONForScript(make(chan int)); err == nil {
+		t.Errorf("EscapeJSONForScript of a channel didn't fail.")
+	}
+	if _, err := EscapeJSONForAttribute(make(chan int), '"'); err == nil {
+		t.Errorf("EscapeJSONForAttribute of a channel didn't fail.")
+	}
+}
+
+func ExampleEscapeJSONForScript() {
+	b, _ := EscapeJSONForScript(map[string]string{"title": "</script> & more"})
+	fmt.Printf("<script type=\"application/json\">%s</script>\n", b)
+	// Output:
+	// <script type="application/json">{"title":"\u003c/script\u003e \u0026 more"}</script>
+}
+
+func ExampleEscapeJSONForAttribute() {
+	s, _ := EscapeJSONForAttribute(map[string]string{"name": "O'Brien"}, '\'')
+	fmt.Printf("<div data-state='%s'>\n", s)
+	// Output:
+	// <div data-state='{"name":"O&#39;Brien"}'>
+}
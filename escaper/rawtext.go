@@ -0,0 +1,227 @@
+package escaper
+
+import (
+	"github.com/Dancapistan/htmlutil/checker"
+	"strings"
+)
+
+const htmlLt = "&lt;"
+
+// EscapeEscapableRawText returns the argument escaped for use as the content
+// of the escapable raw text element named by tag, which is "textarea" or
+// "title". See checker.IsValidEscapableRawText.
+//
+// Character references are decoded in escapable raw text, so anything that
+// looks like the element's end tag, like "</textarea" in any case, has its "<"
+// escaped as &lt;. Ambiguous ampersands and legacy character references are
+// escaped, too. See EscapeAmbiguousAmpersands.
+//
+// The parser drops a newline at the very start of a <textarea>, so if the text
+// starts with one, another newline is added in front to keep it.
+//
+func EscapeEscapableRawText(tag, text string) string {
+	if b := escapeEscapableRawText(tag, false, text); b != nil {
+		return bytesToString(b)
+	}
+	return text
+}
+
+// AppendEscapableRawText appends the argument, escaped as by
+// EscapeEscapableRawText, to dst and returns the extended buffer. It doesn't
+// allocate if dst has enough capacity.
+//
+func AppendEscapableRawText(dst []byte, tag, text string) []byte {
+	return appendEscapableRawText(dst, tag, false, text)
+}
+
+// escapeRCDATA implements Escape for RCDATA. It escapes the text for every
+// escapable raw text element at once, <textarea> and <title>: the "<" of
+// every "</" is escaped, and a newline at the start is kept, as in a
+// <textarea>.
+//
+func escapeRCDATA(text string) string {
+	if b := escapeEscapableRawText("", true, text); b != nil {
+		return bytesToString(b)
+	}
+	return text
+}
+
+// appendEscapableRawText implements AppendEscapableRawText, and escapeRCDATA
+// if anyTag is true.
+//
+func appendEscapableRawText(dst []byte, tag string, anyTag bool, text string) []byte {
+
+	if dropsLeadingNewline(tag, anyTag, text) {
+		dst = append(dst, '\n')
+	}
+
+	// Splitting the text at a "<" can't change how its ampersands are escaped,
+	// because only a semicolon after a name makes a difference.
+
+	for {
+		i := indexEscapableEndTag(text, tag, anyTag)
+		if i == -1 {
+			return appendEscapedAttributeValue(dst, text, nil)
+		}
+		dst = appendEscapedAttributeValue(dst, text[:i], nil)
+		dst = append(dst, htmlLt...)
+		text = text[i+1:]
+	}
+}
+
+// escapeEscapableRawText implements EscapeEscapableRawText, escapeRCDATA, and
+// the []byte version. It returns nil if nothing needs to be escaped.
+//
+func escapeEscapableRawText(tag string, anyTag bool, text string) []byte {
+
+	if !dropsLeadingNewline(tag, anyTag, text) && indexEscapableEndTag(text, tag, anyTag) == -1 &&
+		!hasUnsafeAmpersand(text) {
+		return nil
+	}
+
+	return appendEscapableRawText(make([]byte, 0, escapedCapacity(text)+1), tag, anyTag, text)
+}
+
+// indexEscapableEndTag returns the index of the first "</" in the text that
+// could start the end tag of the escapable raw text element named by tag, or
+// of any element if anyTag is true, or -1 if there isn't one. The tag name
+// doesn't need to be followed by anything, because the text after it could
+// finish the end tag.
+//
+func indexEscapableEndTag(text, tag string, anyTag bool) int {
+	if anyTag {
+		return strings.Index(text, "</")
+	}
+	return checker.IndexEndTag(text, tag, "")
+}
+
+// dropsLeadingNewline returns true if the parser would drop the newline at the
+// start of the text, which only happens in a textarea, or could happen, if
+// anyTag is true. A carriage return counts, because the parser turns it into a
+// newline first.
+//
+func dropsLeadingNewline(tag string, anyTag bool, text string) bool {
+	return len(text) > 0 && (text[0] == '\n' || text[0] == '\r') &&
+		(anyTag || strings.EqualFold(tag, "textarea"))
+}
+
+// EscapeScriptContent returns the argument rewritten so that it can be used as
+// the content of a <script> element. See checker.IsValidRawText.
+//
+// Nothing is decoded in a script, so character references can't be used.
+// Instead, the sequences that could end the script early, or hide its end tag,
+// are rewritten using JavaScript escapes that keep their meaning inside string
+// literals, template literals, and regular expressions:
+//
+//     "</script"  becomes  "<\/script"
+//     "<!--"      becomes  "\u003C!--"
+//     "<script"   becomes  "\u003Cscript"
+//
+// The letters of "script" match in any case. The escapes are valid in JSON
+// strings, too. The sequences don't belong anywhere else in a script, so the
+// rewrite doesn't change the meaning of sensible code.
+//
+func EscapeScriptContent(s string) string {
+	if b := escapeScriptContent(s); b != nil {
+		return bytesToString(b)
+	}
+	return s
+}
+
+// AppendScriptContent appends the argument, rewritten as by
+// EscapeScriptContent, to dst and returns the extended buffer. It doesn't
+// allocate if dst has enough capacity.
+//
+func AppendScriptContent(dst []byte, s string) []byte {
+	for {
+		i := indexUnsafeScript(s)
+		if i == -1 {
+			return append(dst, s...)
+		}
+		dst = append(dst, s[:i]...)
+		if s[i+1] == '/' {
+			dst = append(dst, `<\/`...)
+			s = s[i+2:]
+		} else {
+			dst = append(dst, `\u003C`...)
+			s = s[i+1:]
+		}
+	}
+}
+
+// escapeScriptContent implements EscapeScriptContent and its []byte version.
+// It returns nil if nothing needs to be rewritten.
+//
+func escapeScriptContent(s string) []byte {
+	if indexUnsafeScript(s) == -1 {
+		return nil
+	}
+	return AppendScriptContent(make([]byte, 0, len(s)+16), s)
+}
+
+// indexUnsafeScript returns the index of the "<" of the first "</script",
+// "<!--", or "<script" in the argument, or -1 if there isn't one.
+//
+func indexUnsafeScript(s string) int {
+	for i := 0; ; {
+		j := strings.IndexByte(s[i:], '<')
+		if j == -1 {
+			return -1
+		}
+		i += j
+		rest := s[i+1:]
+		if hasPrefixFold(rest, "/script") || strings.HasPrefix(rest, "!--") ||
+			hasPrefixFold(rest, "script") {
+			return i
+		}
+		i++
+	}
+}
+
+// EscapeStyleContent returns the argument rewritten so that it can be used as
+// the content of a <style> element. See checker.IsValidRawText.
+//
+// Nothing is decoded in a style sheet, so character references can't be used.
+// Instead, the "<" of anything that looks like the end tag, like "</style" in
+// any case, is rewritten as the CSS escape "\3C ", which keeps its meaning in
+// CSS strings and is ignored in comments.
+//
+func EscapeStyleContent(s string) string {
+	if b := escapeStyleContent(s); b != nil {
+		return bytesToString(b)
+	}
+	return s
+}
+
+// AppendStyleContent appends the argument, rewritten as by EscapeStyleContent,
+// to dst and returns the extended buffer. It doesn't allocate if dst has enough
+// capacity.
+//
+func AppendStyleContent(dst []byte, s string) []byte {
+	for {
+		i := checker.IndexEndTag(s, "style", "")
+		if i == -1 {
+			return append(dst, s...)
+		}
+		dst = append(dst, s[:i]...)
+		dst = append(dst, `\3C `...)
+		s = s[i+1:]
+	}
+}
+
+// escapeStyleContent implements EscapeStyleContent and its []byte version. It
+// returns nil if nothing needs to be rewritten.
+//
+func escapeStyleContent(s string) []byte {
+	if checker.IndexEndTag(s, "style", "") == -1 {
+		return nil
+	}
+	return AppendStyleContent(make([]byte, 0, len(s)+16), s)
+}
+
+// hasPrefixFold is like strings.HasPrefix, ignoring the case of ASCII letters.
+// The prefix must be ASCII.
+//
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
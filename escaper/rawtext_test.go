@@ -0,0 +1,122 @@
+package escaper
+
+import (
+	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
+	"testing"
+)
+
+func TestEscapeEscapableRawText(t *testing.T) {
+
+	cases := map[string]string{
+		"":                      "",
+		"plain text":            "plain text",
+		"<b>bold</b>":           "<b>bold</b>",
+		"a < b &amp; c":         "a < b &amp; c",
+		"</textarea>":           "&lt;/textarea>",
+		"x</TextArea y":         "x&lt;/TextArea y",
+		"</textarea</textarea>": "&lt;/textarea&lt;/textarea>",
+		"</textareas>":          "&lt;/textareas>",
+		"</title>":              "</title>",
+		"&dan; &copy 2014":      "&amp;dan; &amp;copy 2014",
+		"\nleading newline":     "\n\nleading newline",
+		"\r\nleading newline":   "\n\r\nleading newline",
+		"trailing newline\n":    "trailing newline\n",
+	}
+
+	escapeTextarea := func(text string) string {
+		return EscapeEscapableRawText("textarea", text)
+	}
+	checkTestCases(t, cases, escapeTextarea, "EscapeEscapableRawText")
+
+	appendTextarea := func(dst []byte, text string) []byte {
+		return AppendEscapableRawText(dst, "textarea", text)
+	}
+	checkAppendAgrees(t, writerTestInputs, appendTextarea, escapeTextarea,
+		"AppendEscapableRawText")
+
+	if output := EscapeEscapableRawText("title", "\n</TITLE>"); output != "\n&lt;/TITLE>" {
+		t.Errorf("Expected the title %q, but got %q.", "\n&lt;/TITLE>", output)
+	}
+}
+
+func TestEscapeEscapableRawText_valid(t *testing.T) {
+	inputs := append([]string{"</textarea>", "</TEXTAREA/", "<</textarea\t"}, writerTestInputs...)
+	for _, tag := range []string{"textarea", "title"} {
+		for _, input := range inputs {
+			output := EscapeEscapableRawText(tag, input)
+			if !checker.IsValidEscapableRawText(tag, output) || checker.HasLegacyCharacterReference(output) {
+				t.Errorf("EscapeEscapableRawText(%q, %q) is %q, which is not valid.", tag, input, output)
+			}
+		}
+	}
+}
+
+func ExampleEscapeEscapableRawText() {
+	fmt.Printf("<textarea>%s</textarea>\n",
+		EscapeEscapableRawText("textarea", "Type </textarea> to end it & go."))
+	// Output:
+	// <textarea>Type &lt;/textarea> to end it & go.</textarea>
+}
+
+func TestEscapeScriptContent(t *testing.T) {
+
+	cases := map[string]string{
+		"":                          "",
+		"var a = 1 < 2;":            "var a = 1 < 2;",
+		`var s = "</script>";`:      `var s = "<\/script>";`,
+		`var s = "</SCRIPT>";`:      `var s = "<\/SCRIPT>";`,
+		`var s = "<!-- <script>";`:  `var s = "\u003C!-- \u003Cscript>";`,
+		`{"html": "<b>hi</b>"}`:     `{"html": "<b>hi</b>"}`,
+		`{"html": "</script><!--"}`: `{"html": "<\/script>\u003C!--"}`,
+		"</style>":                  "</style>",
+	}
+
+	checkTestCases(t, cases, EscapeScriptContent, "EscapeScriptContent")
+	checkAppendAgrees(t, writerTestInputs, AppendScriptContent, EscapeScriptContent,
+		"AppendScriptContent")
+}
+
+func TestEscapeStyleContent(t *testing.T) {
+
+	cases := map[string]string{
+		"":                                 "",
+		"p { color: red }":                 "p { color: red }",
+		"a::after { content: '</style>' }": `a::after { content: '\3C /style>' }`,
+		"/* </STYLE */":                    `/* \3C /STYLE */`,
+		"</script>":                        "</script>",
+	}
+
+	checkTestCases(t, cases, EscapeStyleContent, "EscapeStyleContent")
+	checkAppendAgrees(t, writerTestInputs, AppendStyleContent, EscapeStyleContent,
+		"AppendStyleContent")
+}
+
+func TestEscapeRawText_valid(t *testing.T) {
+	inputs := append([]string{
+		"</script>", "<!--<script>-->", "<!-- <SCRIPT ", "</style", "<<!--<!---->",
+	}, writerTestInputs...)
+	for _, input := range inputs {
+		if output := EscapeScriptContent(input); !checker.IsValidRawText("script", output) {
+			t.Errorf("EscapeScriptContent(%q) is %q, which is not valid.", input, output)
+		}
+		if output := EscapeStyleContent(input); !checker.IsValidRawText("style", output) {
+			t.Errorf("EscapeStyleContent(%q) is %q, which is not valid.", input, output)
+		}
+	}
+}
+
+func ExampleEscapeScriptContent() {
+	fmt.Printf("<script>var html = %s;</script>\n",
+		EscapeScriptContent(`"<!-- <script></script> -->"`))
+	// Output:
+	// <script>var html = "\u003C!-- \u003Cscript><\/script> -->";</script>
+}
+
+// BenchmarkEscapeScriptContent  5000000         242 ns/op        80 B/op        1 allocs/op
+func BenchmarkEscapeScriptContent(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = EscapeScriptContent(`var html = "<p>Hello</p><script>alert(1)</script>";`)
+	}
+}
@@ -0,0 +1,83 @@
+package escaper
+
+import (
+	"github.com/Dancapistan/htmlutil/checker"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// EscapeSrcset returns a srcset attribute value built from the image
+// candidates, escaped for use in double quotes, like
+// "small.png 480w, large.png 1080w".
+//
+// Each URL is normalized, checked against the policy, and percent-encoded as
+// by EscapeURLAttribute. A candidate whose URL the policy rejects is left out,
+// rather than pointing at InvalidURL. As in EscapeURLAttribute, the policy
+// checks the URL with its character references decoded. The parser reads a comma at the start or
+// end of a URL as a separator, so those commas are percent-encoded. Commas
+// inside a URL, as in "/img/w_100,h_50/a.png", are left alone.
+//
+// A descriptor that isn't positive is left out. If a candidate has both a
+// width and a density, only the width is written. See checker.ParseSrcset.
+//
+func EscapeSrcset(candidates []checker.ImageCandidate, policy URLPolicy) string {
+	return bytesToString(AppendSrcset(nil, candidates, policy))
+}
+
+// AppendSrcset appends the srcset built by EscapeSrcset to dst and returns the
+// extended buffer.
+//
+func AppendSrcset(dst []byte, candidates []checker.ImageCandidate, policy URLPolicy) []byte {
+
+	// Build the whole value first, then escape it, because whether an
+	// ampersand needs escaping can depend on what follows it.
+
+	var buf [256]byte
+	srcset := buf[:0]
+
+	for _, c := range candidates {
+
+		var urlBuf [128]byte
+		url := bytesToString(appendNormalizedURL(urlBuf[:0], c.URL))
+		if url == "" || !policy.allowsValue(url) {
+			continue
+		}
+
+		if len(srcset) > 0 {
+			srcset = append(srcset, ", "...)
+		}
+		srcset = appendSrcsetURL(srcset, url)
+
+		switch {
+		case c.Width > 0:
+			srcset = append(srcset, ' ')
+			srcset = strconv.AppendInt(srcset, int64(c.Width), 10)
+			srcset = append(srcset, 'w')
+		case c.Density > 0 && !math.IsInf(c.Density, 1):
+			srcset = append(srcset, ' ')
+			srcset = strconv.AppendFloat(srcset, c.Density, 'g', -1, 64)
+			srcset = append(srcset, 'x')
+		}
+	}
+
+	return appendEscapedAttributeValue(dst, bytesToString(srcset), doubleQuotedReplacements)
+}
+
+// appendSrcsetURL appends the normalized URL to dst, with the commas at its
+// start and end percent-encoded.
+//
+func appendSrcsetURL(dst []byte, url string) []byte {
+
+	for len(url) > 0 && url[0] == ',' {
+		dst = append(dst, "%2C"...)
+		url = url[1:]
+	}
+
+	trimmed := strings.TrimRight(url, ",")
+	dst = append(dst, trimmed...)
+	for i := len(trimmed); i < len(url); i++ {
+		dst = append(dst, "%2C"...)
+	}
+	return dst
+}
@@ -0,0 +1,69 @@
+package escaper
+
+import (
+	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
+	"testing"
+)
+
+func TestEscapeSrcset(t *testing.T) {
+
+	type candidates = []checker.ImageCandidate
+
+	cases := []struct {
+		candidates candidates
+		expected   string
+	}{
+		{nil, ""},
+		{candidates{{URL: "a.png"}}, "a.png"},
+		{candidates{{URL: "a.png", Width: 480}, {URL: "b.png", Width: 1080}}, "a.png 480w, b.png 1080w"},
+		{candidates{{URL: "a.png"}, {URL: "b.png", Density: 1.5}}, "a.png, b.png 1.5x"},
+		{candidates{{URL: "a.png", Width: 480, Density: 2}}, "a.png 480w"},
+		{candidates{{URL: "a.png", Width: -1}, {URL: "b.png", Density: -2}}, "a.png, b.png"},
+		{candidates{{URL: "/img/w_100,h_50/a.png", Width: 100}}, "/img/w_100,h_50/a.png 100w"},
+		{candidates{{URL: ",a.png,", Density: 2}}, "%2Ca.png%2C 2x"},
+		{candidates{{URL: "a.png,,"}}, "a.png%2C%2C"},
+		{candidates{{URL: "my image.png", Density: 1}}, "my%20image.png 1x"},
+		{candidates{{URL: "/a?x=1&copy=2"}}, "/a?x=1&amp;copy=2"},
+		{candidates{{URL: `"><script>`}}, "%22%3E%3Cscript%3E"},
+		{candidates{{URL: "javascript:alert(1)", Density: 1}, {URL: "b.png", Density: 2}}, "b.png 2x"},
+		{candidates{{URL: "javascript&#58;alert(1)", Density: 1}, {URL: "b.png", Density: 2}}, "b.png 2x"},
+		{candidates{{URL: "JavaScript&colon;alert(1)"}, {URL: "&#106;avascript:alert(1)"}}, ""},
+		{candidates{{URL: " java&Tab;script&#x3A;alert(1)", Width: 100}, {URL: "b.png", Width: 200}}, "b.png 200w"},
+		{candidates{{URL: " \t"}, {URL: "b.png", Density: 2}}, "b.png 2x"},
+	}
+
+	for _, c := range cases {
+		if actual := EscapeSrcset(c.candidates, DefaultURLPolicy); actual != c.expected {
+			t.Errorf("EscapeSrcset(%v) is %q, but expected %q.", c.candidates, actual, c.expected)
+		}
+	}
+}
+
+func TestEscapeSrcset_roundTrip(t *testing.T) {
+
+	candidates := []checker.ImageCandidate{
+		{URL: ",a.png,", Density: 1},
+		{URL: "/img/w_100,h_50/b.png", Density: 2},
+		{URL: "c d.png", Density: 3},
+	}
+
+	parsed, errs := checker.ParseSrcset(Unescape(EscapeSrcset(candidates, DefaultURLPolicy)))
+	if len(errs) != 0 {
+		t.Errorf("EscapeSrcset made an invalid srcset: %v", errs)
+	}
+	expected := "[{%2Ca.png%2C 0 1} {/img/w_100,h_50/b.png 0 2} {c%20d.png 0 3}]"
+	if fmt.Sprint(parsed) != expected {
+		t.Errorf("EscapeSrcset round trip is %v, but expected %v.", parsed, expected)
+	}
+}
+
+func ExampleEscapeSrcset() {
+	fmt.Println(EscapeSrcset([]checker.ImageCandidate{
+		{URL: "/img/small.jpg?v=1&copy=2", Width: 480},
+		{URL: "javascript:alert(1)", Width: 800},
+		{URL: "/img/large photo.jpg", Width: 1080},
+	}, DefaultURLPolicy))
+	// Output:
+	// /img/small.jpg?v=1&amp;copy=2 480w, /img/large%20photo.jpg 1080w
+}
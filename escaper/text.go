@@ -0,0 +1,83 @@
+package escaper
+
+import (
+	"strings"
+)
+
+// EscapeText returns the argument escaped for use as the text content of a
+// normal element, like a <p> or a <div>. It escapes as little as it can: "<"
+// becomes &lt;, and ambiguous ampersands and character references without a
+// semicolon, like "&copy 2014" or "&#169 2014", are escaped with &amp;. Valid
+// character references, like "&copy;", and quotes are left alone. See
+// EscapeAmbiguousAmpersands.
+//
+//     Normal elements can have text, character references, other elements,
+//     and comments, but the text must not contain the character "<" (U+003C)
+//     or an ambiguous ampersand.
+//
+// From https://html.spec.whatwg.org/multipage/syntax.html#elements-2
+//
+// Use EscapeTextStrict to escape every ampersand instead.
+//
+func EscapeText(s string) string {
+	if b := escapeText(s, false); b != nil {
+		return bytesToString(b)
+	}
+	return s
+}
+
+// EscapeTextStrict is like EscapeText, except it escapes every ampersand, so
+// the output shows character references like "&copy;" literally.
+//
+func EscapeTextStrict(s string) string {
+	if b := escapeText(s, true); b != nil {
+		return bytesToString(b)
+	}
+	return s
+}
+
+// AppendText appends the argument, escaped as by EscapeText, to dst and
+// returns the extended buffer. It doesn't allocate if dst has enough capacity.
+//
+func AppendText(dst []byte, s string) []byte {
+	return appendEscapedAttributeValue(dst, s, textReplacements)
+}
+
+// AppendTextStrict appends the argument, escaped as by EscapeTextStrict, to dst
+// and returns the extended buffer. It doesn't allocate if dst has enough
+// capacity.
+//
+func AppendTextStrict(dst []byte, s string) []byte {
+	return appendReplaced(dst, s, strictTextReplacements)
+}
+
+var textReplacements = &replacementSet{
+	chars: "<",
+	refs:  [128]string{'<': "&lt;"},
+}
+
+var strictTextReplacements = &replacementSet{
+	chars: "&<",
+	refs:  [128]string{'&': htmlAmp, '<': "&lt;"},
+}
+
+// escapeText implements EscapeText, EscapeTextStrict, and their []byte
+// versions. It returns nil if nothing needs to be escaped.
+//
+func escapeText(s string, strict bool) []byte {
+
+	if strict {
+		if strings.IndexAny(s, strictTextReplacements.chars) == -1 {
+			return nil
+		}
+		return appendReplaced(make([]byte, 0, escapedCapacity(s)), s, strictTextReplacements)
+	}
+
+	// The minimal mode builds on the same ampersand handling as
+	// escapeAmbiguousAmpersandsBuffer.
+
+	if strings.IndexByte(s, '<') == -1 && !hasUnsafeAmpersand(s) {
+		return nil
+	}
+	return appendEscapedAttributeValue(make([]byte, 0, escapedCapacity(s)), s, textReplacements)
+}
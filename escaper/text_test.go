@@ -0,0 +1,80 @@
+package escaper
+
+import (
+	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
+	"strings"
+	"testing"
+)
+
+func TestEscapeText(t *testing.T) {
+
+	cases := map[string]string{
+		"":                       "",
+		"plain text":             "plain text",
+		`"quotes" aren't harmed`: `"quotes" aren't harmed`,
+		"1 < 2 > 0":              "1 &lt; 2 > 0",
+		"<script>":               "&lt;script>",
+		"Tom & Jerry":            "Tom & Jerry",
+		"&amp; &copy; &#169;":    "&amp; &copy; &#169;",
+		"&dan;":                  "&amp;dan;",
+		"&copy 2014":             "&amp;copy 2014",
+		"&notit;":                "&amp;notit;",
+		"a &lt b":                "a &amp;lt b",
+		"&#169 2014":             "&amp;#169 2014",
+		"&#x41":                  "&amp;#x41",
+		"&#65;&#X41":             "&#65;&amp;#X41",
+		"&# 1 &#x &#xg;":         "&# 1 &#x &#xg;",
+	}
+
+	checkTestCases(t, cases, EscapeText, "EscapeText")
+}
+
+func TestEscapeTextStrict(t *testing.T) {
+
+	cases := map[string]string{
+		"":                       "",
+		"plain text":             "plain text",
+		`"quotes" aren't harmed`: `"quotes" aren't harmed`,
+		"1 < 2 > 0":              "1 &lt; 2 > 0",
+		"Tom & Jerry":            "Tom &amp; Jerry",
+		"&amp; &copy;":           "&amp;amp; &amp;copy;",
+		"&dan;":                  "&amp;dan;",
+	}
+
+	checkTestCases(t, cases, EscapeTextStrict, "EscapeTextStrict")
+}
+
+func TestAppendText(t *testing.T) {
+	checkAppendAgrees(t, writerTestInputs, AppendText, EscapeText, "AppendText")
+	checkAppendAgrees(t, writerTestInputs, AppendTextStrict, EscapeTextStrict, "AppendTextStrict")
+}
+
+func TestEscapeText_valid(t *testing.T) {
+	for _, input := range writerTestInputs {
+		if output := Unescape(EscapeTextStrict(input)); output != input {
+			t.Errorf("EscapeTextStrict(%q) decodes to %q.", input, output)
+		}
+		output := EscapeText(input)
+		if strings.IndexByte(output, '<') != -1 || checker.HasAmbiguousAmpersand(output) ||
+			checker.HasLegacyCharacterReference(output) {
+			t.Errorf("EscapeText(%q) is %q, which is not valid text.", input, output)
+		}
+	}
+}
+
+func ExampleEscapeText() {
+	fmt.Println(EscapeText(`Tom & "Jerry" <3 &copy; &copy`))
+	fmt.Println(EscapeTextStrict(`Tom & "Jerry" <3 &copy; &copy`))
+	// Output:
+	// Tom & "Jerry" &lt;3 &copy; &amp;copy
+	// Tom &amp; "Jerry" &lt;3 &amp;copy; &amp;copy
+}
+
+// BenchmarkEscapeText  20000000          86.2 ns/op         0 B/op        0 allocs/op
+func BenchmarkEscapeText(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = EscapeText(`Tom & "Jerry" &amp; friends`)
+	}
+}
@@ -0,0 +1,154 @@
+package escaper
+
+import (
+	"github.com/Dancapistan/htmlutil/checker"
+	"github.com/Dancapistan/htmlutil/internal/charref"
+	"strings"
+	"unicode/utf8"
+)
+
+// Unescape returns a copy of the argument with its character references
+// decoded, following the rules for text content in the WHATWG character
+// reference state:
+//
+// https://html.spec.whatwg.org/multipage/parsing.html#character-reference-state
+//
+// Named references (e.g. "&hearts;"), decimal references (e.g. "&#9829;"), and
+// hexadecimal references (e.g. "&#x2665;") are decoded. Numeric references to
+// U+0000, surrogates, or values beyond U+10FFFF decode to U+FFFD, and the C1
+// control code points are replaced using the Windows-1252 table, the same way a
+// browser would. Legacy named references without a semicolon, like "&copy",
+// are decoded using the longest matching name, so "&notit;" becomes "¬it;".
+// Anything that is not a character reference, like an ambiguous ampersand, is
+// left alone.
+//
+func Unescape(s string) string {
+	if b := unescape(s, false); b != nil {
+		return bytesToString(b)
+	}
+	return s
+}
+
+// UnescapeAttributeValue returns a copy of the argument with its character
+// references decoded, following the rules for attribute values. It reverses
+// EscapeAttributeValueDoubleQuoted for any value that doesn't itself contain
+// valid character references.
+//
+// The only difference from Unescape is that, for historical reasons, a legacy
+// reference without a semicolon is not decoded when it is followed by "=" or
+// an alphanumeric character. For example, "?a=1&copy=2" is left as-is.
+//
+// See Unescape for the details.
+//
+func UnescapeAttributeValue(val string) string {
+	if b := unescape(val, true); b != nil {
+		return bytesToString(b)
+	}
+	return val
+}
+
+// AppendUnescaped appends the argument, with its character references decoded
+// as by Unescape, to dst and returns the extended buffer. It doesn't allocate
+// if dst has enough capacity.
+//
+func AppendUnescaped(dst []byte, s string) []byte {
+	return appendUnescaped(dst, s, false)
+}
+
+// AppendUnescapedAttributeValue appends the argument, with its character
+// references decoded as by UnescapeAttributeValue, to dst and returns the
+// extended buffer. It doesn't allocate if dst has enough capacity.
+//
+func AppendUnescapedAttributeValue(dst []byte, val string) []byte {
+	return appendUnescaped(dst, val, true)
+}
+
+// unescape implements Unescape, UnescapeAttributeValue, and their []byte
+// versions. It returns nil if the argument has no ampersands.
+//
+func unescape(s string, inAttribute bool) []byte {
+
+	if strings.IndexByte(s, unicodeAmpersand) == -1 {
+		return nil
+	}
+
+	// Decoded references are almost always shorter than the references
+	// themselves, so the input's length is a good guess for the capacity.
+
+	return appendUnescaped(make([]byte, 0, len(s)), s, inAttribute)
+}
+
+// appendUnescaped implements AppendUnescaped and
+// AppendUnescapedAttributeValue.
+//
+func appendUnescaped(b []byte, s string, inAttribute bool) []byte {
+
+	for {
+		ampIdx := strings.IndexByte(s, unicodeAmpersand)
+		if ampIdx == -1 {
+			return append(b, s...)
+		}
+		b = append(b, s[:ampIdx]...)
+		s = s[ampIdx:]
+
+		var n int
+		if len(s) > 1 && s[1] == '#' {
+			b, n = appendNumericReference(b, s)
+		} else {
+			b, n = appendNamedReference(b, s, inAttribute)
+		}
+
+		if n == 0 {
+			// Not a character reference. Keep the ampersand.
+			b = append(b, unicodeAmpersand)
+			n = 1
+		}
+		s = s[n:]
+	}
+}
+
+// appendNamedReference decodes the named character reference at the start of
+// s, which begins with an ampersand, and appends it to b. It returns the number
+// of bytes of s that were consumed, or 0 if s doesn't start with a named
+// character reference.
+//
+func appendNamedReference(b []byte, s string, inAttribute bool) ([]byte, int) {
+
+	name, semicolon := checker.MatchCharacterReference(s[1:])
+	if name == "" {
+		return b, 0
+	}
+
+	consumed := len(name) + 1
+	if semicolon {
+		consumed++
+	} else if inAttribute && consumed < len(s) && charref.KeepsLegacyReference(s[consumed]) {
+		return b, 0
+	}
+
+	first, second, _ := checker.CharacterReferenceRunes(name)
+
+	b = utf8.AppendRune(b, first)
+	if second != 0 {
+		b = utf8.AppendRune(b, second)
+	}
+	return b, consumed
+}
+
+// appendNumericReference decodes the numeric character reference at the start
+// of s, which begins with "&#", and appends it to b. It returns the number of
+// bytes of s that were consumed, or 0 if s doesn't start with a numeric
+// character reference.
+//
+func appendNumericReference(b []byte, s string) ([]byte, int) {
+
+	value, n, _ := charref.ParseNumeric(s)
+	if n == 0 {
+		return b, 0
+	}
+	return utf8.AppendRune(b, charref.Decode(value)), n
+}
+
+func isASCIIAlphanumeric(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,139 @@
+package escaper
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestUnescape(t *testing.T) {
+	cases := map[string]string{
+		"":                     "",
+		"plain text":           "plain text",
+		"&amp;":                "&",
+		"&AMP;":                "&",
+		"&lt;b&gt;":            "<b>",
+		"I &hearts; HTML":      "I ♥ HTML",
+		"&nLt;":                "≪⃒",
+		"&Afr;":                "\U0001D504",
+		"&#9829;":              "♥",
+		"&#x2665;":             "♥",
+		"&#X2665;":             "♥",
+		"&#x1F600;":            "\U0001F600",
+		"&#65":                 "A",  // missing semicolon
+		"&#65a":                "Aa", // missing semicolon
+		"&#x41g;":              "Ag;",
+		"&#0;":                 "�",      // null
+		"&#xD800;":             "�",      // surrogate
+		"&#1114112;":           "�",      // out of range
+		"&#99999999999999999;": "�",      // way out of range
+		"&#x80;":               "€",      // Windows-1252
+		"&#150;":               "–",      // Windows-1252
+		"&#x81;":               "\u0081", // not in the Windows-1252 table
+		"&#xFFFF;":             "\uFFFF", // noncharacters are kept
+		"&#;":                  "&#;",
+		"&#x;":                 "&#x;",
+		"&#":                   "&#",
+		"&":                    "&",
+		"&;":                   "&;",
+		"&funky;":              "&funky;", // ambiguous ampersand
+		"&&amp;;":              "&&;",
+		"\u2318 &amp; \u2318":  "\u2318 & \u2318",
+	}
+	checkTestCases(t, cases, Unescape, "Unescape")
+	checkTestCases(t, cases, UnescapeAttributeValue, "UnescapeAttributeValue")
+}
+
+func TestUnescape_legacy(t *testing.T) {
+	cases := map[string]string{
+		"&amp":        "&",
+		"&copy 2014":  "© 2014",
+		"&copy2014":   "©2014",
+		"&notit;":     "¬it;",
+		"&notin;":     "∉",
+		"&notin":      "¬in",
+		"&ltx":        "<x",
+		"&lt=":        "<=",
+		"?a=1&copy=2": "?a=1©=2",
+		"&hearts":     "&hearts", // not a legacy name
+		"&Amp":        "&Amp",
+	}
+	checkTestCases(t, cases, Unescape, "Unescape")
+}
+
+func TestUnescapeAttributeValue_legacy(t *testing.T) {
+	cases := map[string]string{
+		"&amp":        "&",
+		"&copy 2014":  "© 2014",
+		"&copy2014":   "&copy2014",
+		"&notit;":     "&notit;",
+		"&notin;":     "∉",
+		"&notin":      "&notin",
+		"&ltx":        "&ltx",
+		"&lt=":        "&lt=",
+		"&lt;=":       "<=",
+		"?a=1&copy=2": "?a=1&copy=2",
+		"?a=1&copy&b": "?a=1©&b",
+		"&hearts":     "&hearts", // not a legacy name
+	}
+	checkTestCases(t, cases, UnescapeAttributeValue, "UnescapeAttributeValue")
+}
+
+func TestUnescapeAttributeValue_roundTrip(t *testing.T) {
+	values := []string{
+		"",
+		"plain",
+		`My name is "Franklin".`,
+		`An "&ambiguous;" ampersand.`,
+		"this & that",
+		"&#this",
+		"\u2318 &poi; \u2318",
+		"&copy 2014",
+		"&notit;",
+		"?a=1&copy=2",
+	}
+	for _, val := range values {
+		escaped := EscapeAttributeValueDoubleQuoted(val)
+		if actual := UnescapeAttributeValue(escaped); actual != val {
+			t.Errorf("Expecting UnescapeAttributeValue(%q) to be %q, but got %q.",
+				escaped, val, actual)
+		}
+	}
+}
+
+func ExampleUnescape() {
+	fmt.Println(Unescape("I &hearts; &lt;b&gt; &#x26; &#169;"))
+	// Output:
+	// I ♥ <b> & ©
+}
+
+// BenchmarkUnescape_none 100000000          7.82 ns/op         0 B/op        0 allocs/op
+func BenchmarkUnescape_none(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Unescape("nothing to be unescaped")
+	}
+}
+
+// BenchmarkUnescape_mixed  5000000         208   ns/op        64 B/op        2 allocs/op
+// BenchmarkUnescape_mixed  5000000         242   ns/op        48 B/op        1 allocs/op # append, no copy to string
+func BenchmarkUnescape_mixed(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Unescape("I &hearts; &lt;b&gt; &#x26; &#169;")
+	}
+}
+
+func TestAppendUnescaped(t *testing.T) {
+	checkAppendAgrees(t, bytesTestInputs, AppendUnescaped, Unescape, "AppendUnescaped")
+	checkAppendAgrees(t, bytesTestInputs, AppendUnescapedAttributeValue,
+		UnescapeAttributeValue, "AppendUnescapedAttributeValue")
+}
+
+// BenchmarkAppendUnescaped_mixed 10000000         193 ns/op         0 B/op        0 allocs/op
+func BenchmarkAppendUnescaped_mixed(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendUnescaped(buf[:0], "I &hearts; &lt;b&gt; &#x26; &#169;")
+	}
+}
@@ -0,0 +1,209 @@
+package escaper
+
+import (
+	"github.com/Dancapistan/htmlutil/internal/urlscheme"
+	"strings"
+)
+
+// InvalidURL replaces a URL that a URLPolicy rejects. It is harmless wherever a
+// URL can go.
+//
+const InvalidURL = "about:invalid"
+
+// URLPolicy says which URLs EscapeURLAttribute lets through. Relative URLs,
+// like "/about" or "?page=2", are always allowed.
+//
+type URLPolicy struct {
+	// Schemes lists the allowed schemes, like "https", without the colon. They
+	// match in any case.
+	Schemes []string
+
+	// AllowDataImages allows "data:" URLs of raster images, like
+	// "data:image/png;base64,...", even if "data" isn't in Schemes. SVG images
+	// are not included, because they can contain scripts.
+	AllowDataImages bool
+}
+
+// DefaultURLPolicy allows web links, email addresses, and phone numbers, and
+// rejects everything else, including "javascript:", "vbscript:", and "data:"
+// URLs.
+//
+var DefaultURLPolicy = URLPolicy{
+	Schemes: append([]string(nil), urlscheme.Safe[:]...),
+}
+
+// dataImageTypes are the media types AllowDataImages allows.
+//
+var dataImageTypes = []string{
+	"image/avif",
+	"image/bmp",
+	"image/gif",
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+	"image/x-icon",
+}
+
+// EscapeURLAttribute returns the argument escaped for use as the double-quoted
+// value of an attribute that holds a URL, like href, src, action, or
+// formaction. If the policy doesn't allow the URL, InvalidURL is returned
+// instead.
+//
+// First, the URL is normalized the way a browser does: leading and trailing
+// control characters and spaces are removed, and so are all tabs and newlines.
+// That way, "java\tscript:" can't sneak past the policy. Then, the scheme is
+// checked against the policy, with character references decoded the way the
+// browser decodes them, so "javascript&#58;" is rejected, too. Next, the characters that don't belong in a URL,
+// like spaces, quotes, "<", ">", and non-ASCII characters, are
+// percent-encoded as UTF-8. Existing percent escapes are left alone. Finally,
+// the result is escaped like EscapeAttributeValueDoubleQuoted does, which
+// takes care of ampersands, as in "?a=1&copy=2".
+//
+// Because quotes are percent-encoded, the result is safe in a single-quoted
+// attribute value, too.
+//
+func EscapeURLAttribute(val string, policy URLPolicy) string {
+	if b := escapeURLAttribute(val, policy); b != nil {
+		return bytesToString(b)
+	}
+	return val
+}
+
+// AppendURLAttribute appends the argument, escaped as by EscapeURLAttribute, to
+// dst and returns the extended buffer. It doesn't allocate for short URLs if
+// dst has enough capacity.
+//
+func AppendURLAttribute(dst []byte, val string, policy URLPolicy) []byte {
+
+	// Normalize and percent-encode first, so the policy sees what the browser
+	// will see. Percent-encoding doesn't change the scheme, because the
+	// characters of a scheme are never encoded.
+
+	var buf [128]byte
+	url := bytesToString(appendNormalizedURL(buf[:0], val))
+
+	if !policy.allowsValue(url) {
+		return append(dst, InvalidURL...)
+	}
+
+	return appendEscapedAttributeValue(dst, url, doubleQuotedReplacements)
+}
+
+// escapeURLAttribute implements EscapeURLAttribute and its []byte version. It
+// returns nil if nothing needs to be escaped.
+//
+func escapeURLAttribute(val string, policy URLPolicy) []byte {
+
+	// A URL made only of safe characters is already normalized, and quotes
+	// aren't safe, so only the policy and the ampersands are left to check.
+
+	if isNormalizedURL(val) && policy.allowsValue(val) && !hasUnsafeAmpersand(val) {
+		return nil
+	}
+	return AppendURLAttribute(make([]byte, 0, escapedCapacity(val)), val, policy)
+}
+
+// isNormalizedURL returns true if appendNormalizedURL wouldn't change the URL.
+//
+func isNormalizedURL(url string) bool {
+	for i := 0; i < len(url); i++ {
+		if c := url[i]; c >= 0x80 || !urlSafe[c] {
+			return false
+		}
+	}
+	return true
+}
+
+// allowsValue returns true if the policy allows the URL that a browser reads
+// from an attribute value holding the normalized URL. The browser decodes the
+// character references first, so "javascript&#58;" is checked as
+// "javascript:".
+//
+func (policy URLPolicy) allowsValue(url string) bool {
+	if strings.IndexByte(url, '&') == -1 {
+		return policy.allows(url)
+	}
+	var buf [128]byte
+	return policy.allows(bytesToString(appendDecodedURL(buf[:0], url)))
+}
+
+// appendDecodedURL appends the URL in the attribute value to dst, with its
+// character references decoded, and then normalized by appendNormalizedURL.
+//
+func appendDecodedURL(dst []byte, val string) []byte {
+	return appendNormalizedURL(dst, UnescapeAttributeValue(val))
+}
+
+// allows returns true if the policy allows the normalized URL, which has no
+// character references left to decode. See allowsValue.
+//
+func (policy URLPolicy) allows(url string) bool {
+
+	scheme, rest, ok := urlscheme.Parse(url)
+	if !ok {
+		return true // relative
+	}
+
+	for _, allowed := range policy.Schemes {
+		if strings.EqualFold(scheme, allowed) {
+			return true
+		}
+	}
+
+	if policy.AllowDataImages && strings.EqualFold(scheme, "data") {
+		mediaType := rest
+		if i := strings.IndexAny(rest, ";,"); i != -1 {
+			mediaType = rest[:i]
+		}
+		for _, allowed := range dataImageTypes {
+			if strings.EqualFold(mediaType, allowed) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// appendNormalizedURL appends the URL to dst, with leading and trailing C0
+// controls and spaces removed, tabs and newlines removed, and the characters
+// that don't belong in a URL percent-encoded.
+//
+func appendNormalizedURL(dst []byte, url string) []byte {
+
+	// "Remove any leading and trailing C0 control or space from input."
+
+	start, end := 0, len(url)
+	for start < end && url[start] <= ' ' {
+		start++
+	}
+	for end > start && url[end-1] <= ' ' {
+		end--
+	}
+	url = url[start:end]
+
+	for i := 0; i < len(url); i++ {
+		c := url[i]
+		switch {
+		case c == '\t' || c == '\n' || c == '\r':
+			// "Remove all ASCII tab or newline from input."
+		case c < 0x80 && urlSafe[c]:
+			dst = append(dst, c)
+		default:
+			dst = append(dst, '%', hexDigits[c>>4], hexDigits[c&0xF])
+		}
+	}
+	return dst
+}
+
+const hexDigits = "0123456789ABCDEF"
+
+// urlSafe has the ASCII characters that are left as they are in a URL. See
+// urlscheme.Characters.
+//
+var urlSafe = func() (safe [128]bool) {
+	for i := 0; i < len(urlscheme.Characters); i++ {
+		safe[urlscheme.Characters[i]] = true
+	}
+	return safe
+}()
@@ -0,0 +1,141 @@
+package escaper
+
+import (
+	"fmt"
+	"github.com/Dancapistan/htmlutil/checker"
+	"testing"
+)
+
+func TestEscapeURLAttribute(t *testing.T) {
+
+	cases := map[string]string{
+		"":                                "",
+		"https://example.com/":            "https://example.com/",
+		"HTTPS://example.com/":            "HTTPS://example.com/",
+		"/about":                          "/about",
+		"?page=2#top":                     "?page=2#top",
+		"mailto:dan@example.com":          "mailto:dan@example.com",
+		"tel:+1-555-0100":                 "tel:+1-555-0100",
+		"/search?q=a&b=c":                 "/search?q=a&b=c",
+		"/search?q=a&copy=c":              "/search?q=a&amp;copy=c",
+		"/search?q=a&amp;b=c":             "/search?q=a&amp;b=c",
+		"/a b":                            "/a%20b",
+		"/café":                           "/caf%C3%A9",
+		"/100%25":                         "/100%25",
+		`/"><script>`:                     "/%22%3E%3Cscript%3E",
+		"/it's":                           "/it%27s",
+		"  https://example.com/ \n":       "https://example.com/",
+		"/a\tb\nc\rd":                     "/abcd",
+		"javascript:alert(1)":             InvalidURL,
+		"JavaScript:alert(1)":             InvalidURL,
+		" \x01javascript:alert(1)":        InvalidURL,
+		"java\tscript:alert(1)":           InvalidURL,
+		"java\nscript:alert(1)":           InvalidURL,
+		"vbscript:msgbox(1)":              InvalidURL,
+		"data:text/html,<script>":         InvalidURL,
+		"data:image/png;base64,iVBORw0K=": InvalidURL,
+		"ftp://example.com/":              InvalidURL,
+		"javascript&#58;alert(1)":         InvalidURL,
+		"javascript&colon;alert(1)":       InvalidURL,
+		"&#106;avascript:alert(1)":        InvalidURL,
+		"JAVASCRIPT&#58;alert(1)":         InvalidURL,
+		"JavaScript&COLON;alert(1)":       "JavaScript&amp;COLON;alert(1)",
+		"&#74;avascript:alert(1)":         InvalidURL,
+		"&#X6A;avascript&#X3A;alert(1)":   InvalidURL,
+		"javascript&#58alert(1)":          InvalidURL,
+		"  javascript&#58;alert(1) \n":    InvalidURL,
+		"\t&#106;avascript:alert(1)":      InvalidURL,
+		"&#32;javascript:alert(1)":        InvalidURL,
+		"java&Tab;script:alert(1)":        InvalidURL,
+		"java&NewLine;script:alert(1)":    InvalidURL,
+		"/a?b=1&#38;c=2":                  "/a?b=1&#38;c=2",
+		"java script:alert(1)":            "java%20script:alert(1)",
+		"./javascript:alert(1)":           "./javascript:alert(1)",
+		"/javascript:alert(1)":            "/javascript:alert(1)",
+		"1javascript:alert(1)":            "1javascript:alert(1)",
+	}
+
+	checkTestCases(t, cases, func(s string) string { return EscapeURLAttribute(s, DefaultURLPolicy) }, "EscapeURLAttribute")
+}
+
+func TestEscapeURLAttribute_checkerAgrees(t *testing.T) {
+
+	// checker.IsValid decodes URLs with its own code, so it must agree with
+	// DefaultURLPolicy about which URLs are safe.
+
+	inputs := append([]string{
+		"javascript:alert(1)", "javascript&#58;alert(1)", "javascript&colon;alert(1)",
+		"&#106;avascript:alert(1)", "&#x4A;AVASCRIPT&#x3a;alert(1)", "java&Tab;script:alert(1)",
+		"&#9;javascript:alert(1)", "&#xD800;javascript:alert(1)", "&#133;javascript:alert(1)",
+		"jav&eacute;script:alert(1)", "&#104;ttps://example.com/", "about:invalid",
+		"javascript&#58alert(1)", "javascript&#0058;alert(1)", "javascript&#99999999999;",
+	}, writerTestInputs...)
+
+	for _, input := range inputs {
+		normalized := bytesToString(appendNormalizedURL(nil, input))
+		url := bytesToString(appendEscapedAttributeValue(nil, normalized, doubleQuotedReplacements))
+		if expected, actual := DefaultURLPolicy.allowsValue(url), checker.IsValid(checker.URL, url); url != InvalidURL && actual != expected {
+			t.Errorf("checker.IsValid(URL, %q) is %v, but DefaultURLPolicy gives %v.", url, actual, expected)
+		}
+	}
+}
+
+func TestEscapeURLAttribute_dataImages(t *testing.T) {
+
+	policy := URLPolicy{Schemes: []string{"https"}, AllowDataImages: true}
+
+	cases := map[string]string{
+		"https://example.com/a.png":       "https://example.com/a.png",
+		"data:image/png;base64,iVBORw0K=": "data:image/png;base64,iVBORw0K=",
+		"DATA:Image/GIF,GIF89a":           "DATA:Image/GIF,GIF89a",
+		"data:image/svg+xml,<svg>":        InvalidURL,
+		"data:text/html,<script>":         InvalidURL,
+		"data:image/pngx;base64,AAAA":     InvalidURL,
+		"data:,hello":                     InvalidURL,
+		"http://example.com/":             InvalidURL,
+	}
+
+	checkTestCases(t, cases, func(s string) string { return EscapeURLAttribute(s, policy) }, "EscapeURLAttribute")
+}
+
+func TestEscapeURLAttribute_noSchemes(t *testing.T) {
+
+	cases := map[string]string{
+		"/about":               "/about",
+		"https://example.com/": InvalidURL,
+	}
+
+	checkTestCases(t, cases, func(s string) string { return EscapeURLAttribute(s, URLPolicy{}) }, "EscapeURLAttribute")
+}
+
+func TestAppendURLAttribute(t *testing.T) {
+	checkAppendAgrees(t, writerTestInputs,
+		func(dst []byte, s string) []byte { return AppendURLAttribute(dst, s, DefaultURLPolicy) },
+		func(s string) string { return EscapeURLAttribute(s, DefaultURLPolicy) },
+		"AppendURLAttribute")
+}
+
+func TestEscapeURLAttribute_valid(t *testing.T) {
+	for _, input := range writerTestInputs {
+		output := EscapeURLAttribute(input, DefaultURLPolicy)
+		if !checker.IsValidAttributeValueDoubleQuoted(output) || !checker.IsValidAttributeValueSingleQuoted(output) {
+			t.Errorf("EscapeURLAttribute(%q) is %q, which is not a valid quoted attribute value.", input, output)
+		}
+	}
+}
+
+func ExampleEscapeURLAttribute() {
+	fmt.Println(EscapeURLAttribute("/search?q=café&copy=1", DefaultURLPolicy))
+	fmt.Println(EscapeURLAttribute(" java\tscript:alert(1)", DefaultURLPolicy))
+	// Output:
+	// /search?q=caf%C3%A9&amp;copy=1
+	// about:invalid
+}
+
+// BenchmarkEscapeURLAttribute  10000000         144 ns/op         0 B/op        0 allocs/op
+func BenchmarkEscapeURLAttribute(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = EscapeURLAttribute("https://example.com/search?q=html&page=2", DefaultURLPolicy)
+	}
+}
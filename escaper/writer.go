@@ -0,0 +1,146 @@
+package escaper
+
+import (
+	"io"
+	"strings"
+)
+
+// NewAttributeValueWriter returns a writer that escapes an attribute value as
+// it is written, and writes the result to w. The quote argument is the quote
+// character the value is enclosed in, either '"' or '\'', and it is escaped
+// along with the ambiguous ampersands and legacy character references. The
+// writer doesn't write the enclosing quotes.
+//
+// Whether an ampersand needs escaping depends on the characters that follow it,
+// so the writer holds back an ampersand and the alphanumeric characters after
+// it until the next Write shows how the reference ends. Close must be called
+// to flush them. Close doesn't close w.
+//
+// The output is the same as EscapeAttributeValueDoubleQuoted or
+// EscapeAttributeValueSingleQuoted would give for everything written, however
+// it was split across calls to Write. The exception is an ampersand followed
+// by more alphanumeric characters than any reference name has. The writer
+// escapes it, whatever follows, rather than hold back the characters without
+// bound.
+//
+// It panics if quote is not '"' or '\''.
+//
+func NewAttributeValueWriter(w io.Writer, quote byte) io.WriteCloser {
+	return &attributeValueWriter{w: w, set: attributeReplacements(quote)}
+}
+
+// NewAmbiguousAmpersandWriter returns a writer that escapes ambiguous
+// ampersands and legacy character references as they are written, like
+// EscapeAmbiguousAmpersands, and writes the result to w.
+//
+// See NewAttributeValueWriter for how the writer handles references that are
+// split across calls to Write. Close must be called to flush the end of the
+// output.
+//
+func NewAmbiguousAmpersandWriter(w io.Writer) io.WriteCloser {
+	return &attributeValueWriter{w: w}
+}
+
+// attributeValueWriter implements NewAttributeValueWriter and
+// NewAmbiguousAmpersandWriter.
+//
+type attributeValueWriter struct {
+	w       io.Writer
+	set     *replacementSet // The characters to replace besides ampersands, if any.
+	pending []byte          // The undecided reference held back from the last Write.
+	buf     []byte          // The escaped output, reused between calls.
+	err     error           // The first error from w.
+}
+
+func (w *attributeValueWriter) Write(p []byte) (int, error) {
+
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	data := p
+	if len(w.pending) > 0 {
+		w.pending = append(w.pending, p...)
+		data = w.pending
+	}
+
+	cut := undecidedReference(bytesToString(data))
+	if err := w.flush(data[:cut]); err != nil {
+		return 0, err
+	}
+
+	rest := data[cut:]
+	if len(rest) > maxUndecidedReference {
+		w.buf = append(append(w.buf[:0], htmlAmp...), rest[1:]...)
+		if _, w.err = w.w.Write(w.buf); w.err != nil {
+			return 0, w.err
+		}
+		rest = nil
+	}
+	w.pending = append(w.pending[:0], rest...)
+
+	return len(p), nil
+}
+
+// longestReferenceName is the length of the longest character reference name,
+// "CounterClockwiseContourIntegral".
+//
+const longestReferenceName = 31
+
+// maxUndecidedReference is the most a writer holds back: an ampersand, a name,
+// and the byte that shows whether a semicolon ends it.
+//
+const maxUndecidedReference = len("&") + longestReferenceName + 1
+
+// Close writes out anything that was held back. It doesn't close the
+// underlying writer.
+//
+func (w *attributeValueWriter) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	err := w.flush(w.pending)
+	w.pending = w.pending[:0]
+	return err
+}
+
+// flush escapes b and writes it to the underlying writer.
+//
+func (w *attributeValueWriter) flush(b []byte) error {
+
+	if len(b) == 0 {
+		return nil
+	}
+
+	// Whether an ampersand is escaped depends only on whether its name, or its
+	// digits, are followed by a semicolon, and undecidedReference made sure b
+	// doesn't end in the middle of either. So b can be escaped on its own.
+
+	w.buf = appendEscapedAttributeValue(w.buf[:0], bytesToString(b), w.set)
+	_, w.err = w.w.Write(w.buf)
+	return w.err
+}
+
+// undecidedReference returns the index of the last ampersand in s if the rest
+// of s is alphanumeric, after a "#" for a numeric reference, meaning the
+// reference may continue in the next Write. Otherwise, it returns the length
+// of s.
+//
+func undecidedReference(s string) int {
+
+	i := strings.LastIndexByte(s, unicodeAmpersand)
+	if i == -1 {
+		return len(s)
+	}
+
+	name := s[i+1:]
+	if len(name) > 0 && name[0] == '#' {
+		name = name[1:]
+	}
+	for j := 0; j < len(name); j++ {
+		if !isASCIIAlphanumeric(name[j]) {
+			return len(s)
+		}
+	}
+	return i
+}
@@ -0,0 +1,152 @@
+package escaper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// writerTestInputs are written to the streaming writers in every possible
+// pair of chunks.
+var writerTestInputs = append([]string{
+	"&",
+	"&&",
+	"a&",
+	"&amp;&amp",
+	"&amp&xyz",
+	"x &notin; y &notit; z",
+	"&tuesday;&tuesday",
+	"&abcdefghijklmnopqrstuvwxyzabcdefghijklmnop;",
+	"it's \"quoted\" & 'quoted'",
+}, bytesTestInputs...)
+
+func TestAttributeValueWriter(t *testing.T) {
+	newWriter := func(w io.Writer) io.WriteCloser {
+		return NewAttributeValueWriter(w, '"')
+	}
+	checkWriter(t, newWriter, EscapeAttributeValueDoubleQuoted, "NewAttributeValueWriter")
+}
+
+func TestAmbiguousAmpersandWriter(t *testing.T) {
+	checkWriter(t, NewAmbiguousAmpersandWriter, EscapeAmbiguousAmpersands,
+		"NewAmbiguousAmpersandWriter")
+}
+
+func TestAttributeValueWriter_singleQuote(t *testing.T) {
+	newWriter := func(w io.Writer) io.WriteCloser {
+		return NewAttributeValueWriter(w, '\'')
+	}
+	checkWriter(t, newWriter, EscapeAttributeValueSingleQuoted, "NewAttributeValueWriter")
+}
+
+func TestAttributeValueWriter_invalidQuote(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected NewAttributeValueWriter to panic for a backtick.")
+		}
+	}()
+	NewAttributeValueWriter(&bytes.Buffer{}, '`')
+}
+
+func TestAttributeValueWriter_longReference(t *testing.T) {
+
+	// No reference name is this long, so the writer doesn't wait for the end
+	// of the name, even a byte at a time.
+
+	name := strings.Repeat("a", 40)
+	var out bytes.Buffer
+	w := NewAttributeValueWriter(&out, '"')
+	for _, c := range []byte("&" + name) {
+		w.Write([]byte{c})
+	}
+	if expected := "&amp;" + name; out.String() != expected {
+		t.Errorf("Before Close, the writer wrote %q, but expected %q.", out.String(), expected)
+	}
+
+	w.Write([]byte("b;"))
+	w.Close()
+	if expected := "&amp;" + name + "b;"; out.String() != expected {
+		t.Errorf("The writer wrote %q, but expected %q.", out.String(), expected)
+	}
+}
+
+type failingWriter struct{}
+
+var errFailingWriter = errors.New("failed")
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errFailingWriter
+}
+
+func TestAttributeValueWriter_error(t *testing.T) {
+	w := NewAttributeValueWriter(failingWriter{}, '"')
+	if _, err := w.Write([]byte("text")); err != errFailingWriter {
+		t.Errorf("Expected Write to return %v, but got %v.", errFailingWriter, err)
+	}
+	if _, err := w.Write([]byte("more")); err != errFailingWriter {
+		t.Errorf("Expected the error to stick, but got %v.", err)
+	}
+	if err := w.Close(); err != errFailingWriter {
+		t.Errorf("Expected Close to return %v, but got %v.", errFailingWriter, err)
+	}
+}
+
+func ExampleNewAttributeValueWriter() {
+	os.Stdout.WriteString(`<a title="`)
+	w := NewAttributeValueWriter(os.Stdout, '"')
+	fmt.Fprint(w, `Tom &co`)
+	fmt.Fprint(w, `py; "Jerry"`)
+	w.Close()
+	os.Stdout.WriteString("\">\n")
+	// Output:
+	// <a title="Tom &copy; &#34;Jerry&#34;">
+}
+
+// BenchmarkAttributeValueWriter  5000000         396 ns/op         0 B/op        0 allocs/op
+func BenchmarkAttributeValueWriter(b *testing.B) {
+	chunks := [][]byte{[]byte(`An "&ambig`), []byte(`uous;" ampersand &co`), []byte(`py 2014.`)}
+	w := NewAttributeValueWriter(io.Discard, '"')
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, chunk := range chunks {
+			w.Write(chunk)
+		}
+		w.Close()
+	}
+}
+
+// checkWriter writes each input in every possible pair of chunks, and one byte
+// at a time, and checks that the output matches the escape function.
+func checkWriter(t *testing.T, newWriter func(io.Writer) io.WriteCloser, escapeFn func(string) string, testLabel string) {
+
+	for _, input := range writerTestInputs {
+		expected := escapeFn(input)
+
+		for split := 0; split <= len(input); split++ {
+			var buf bytes.Buffer
+			w := newWriter(&buf)
+			w.Write([]byte(input[:split]))
+			w.Write([]byte(input[split:]))
+			w.Close()
+			if buf.String() != expected {
+				t.Errorf("For %s, was expecting %q split at %d => %q, but got %q",
+					testLabel, input, split, expected, buf.String())
+			}
+		}
+
+		var buf bytes.Buffer
+		w := newWriter(&buf)
+		for i := 0; i < len(input); i++ {
+			w.Write([]byte{input[i]})
+		}
+		w.Close()
+		if buf.String() != expected {
+			t.Errorf("For %s, was expecting %q one byte at a time => %q, but got %q",
+				testLabel, input, expected, buf.String())
+		}
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/Dancapistan/htmlutil
+
+go 1.20
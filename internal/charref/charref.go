@@ -0,0 +1,116 @@
+// Package charref decodes numeric character references the way the HTML parser
+// does, for the checker and escaper packages. The named references are in the
+// checker package.
+package charref
+
+import (
+	"unicode/utf8"
+)
+
+// ParseNumeric parses the numeric character reference at the start of s, which
+// begins with "&#", e.g. "&#9829;" or "&#x2665". It returns the code point the
+// digits spell, the number of bytes of the reference, and whether it ends with
+// a semicolon, which is optional. Values beyond U+10FFFF are clamped to
+// U+10FFFF + 1, so that long runs of digits can't overflow.
+//
+// If s doesn't start with a numeric character reference, like "&#" and "&#x"
+// without any digits, n is 0.
+//
+func ParseNumeric(s string) (value rune, n int, semicolon bool) {
+
+	if len(s) < 2 || s[0] != '&' || s[1] != '#' {
+		return 0, 0, false
+	}
+
+	i := 2
+	hex := i < len(s) && (s[i] == 'x' || s[i] == 'X')
+	if hex {
+		i++
+	}
+
+	start := i
+	for ; i < len(s); i++ {
+		digit := digitValue(s[i])
+		if digit == -1 || (!hex && digit > 9) {
+			break
+		}
+		if hex {
+			value = value*16 + digit
+		} else {
+			value = value*10 + digit
+		}
+		if value > utf8.MaxRune {
+			value = utf8.MaxRune + 1
+		}
+	}
+
+	if i == start {
+		return 0, 0, false
+	}
+
+	semicolon = i < len(s) && s[i] == ';'
+	if semicolon {
+		i++
+	}
+	return value, i, semicolon
+}
+
+// Decode returns the character that a numeric character reference to value
+// decodes to. References to U+0000, surrogates, and values beyond U+10FFFF
+// decode to U+FFFD, and the C1 controls are replaced using the Windows-1252
+// table:
+//
+// https://html.spec.whatwg.org/multipage/parsing.html#numeric-character-reference-end-state
+//
+func Decode(value rune) rune {
+	switch {
+	case value == 0, value > utf8.MaxRune, value >= 0xD800 && value <= 0xDFFF:
+		return utf8.RuneError
+	case value >= 0x80 && value <= 0x9F:
+		return windows1252[value-0x80]
+	}
+	return value
+}
+
+// windows1252 maps the C1 control code points to the characters their numeric
+// references decode to. Code points that are not listed in the spec (0x81,
+// 0x8D, 0x8F, 0x90, and 0x9D) are left as-is.
+//
+var windows1252 = [32]rune{
+	'€', '\u0081', '‚', 'ƒ', '„', '…', '†', '‡',
+	'ˆ', '‰', 'Š', '‹', 'Œ', '\u008D', 'Ž', '\u008F',
+	'\u0090', '‘', '’', '“', '”', '•', '–', '—',
+	'˜', '™', 'š', '›', 'œ', '\u009D', 'ž', 'Ÿ',
+}
+
+// KeepsLegacyReference returns true if c, after a legacy named reference
+// without its semicolon in an attribute value, keeps the reference from being
+// decoded, as for "?a=1&copy=2".
+//
+//     If the character reference was consumed as part of an attribute, and
+//     the last character matched is not a U+003B SEMICOLON character (;), and
+//     the next input character is either a U+003D EQUALS SIGN character (=)
+//     or an ASCII alphanumeric, then, for historical reasons, flush code
+//     points consumed as a character reference and switch to the return
+//     state.
+//
+// From https://html.spec.whatwg.org/multipage/parsing.html#named-character-reference-state
+//
+func KeepsLegacyReference(c byte) bool {
+	return c == '=' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// digitValue returns the value of the hexadecimal digit c, or -1 if c is not a
+// hexadecimal digit.
+//
+func digitValue(c byte) rune {
+	switch {
+	case c >= '0' && c <= '9':
+		return rune(c - '0')
+	case c >= 'a' && c <= 'f':
+		return rune(c-'a') + 10
+	case c >= 'A' && c <= 'F':
+		return rune(c-'A') + 10
+	}
+	return -1
+}
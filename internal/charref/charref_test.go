@@ -0,0 +1,67 @@
+package charref
+
+import (
+	"testing"
+)
+
+func TestParseNumeric(t *testing.T) {
+
+	type result struct {
+		value     rune
+		n         int
+		semicolon bool
+	}
+
+	cases := map[string]result{
+		"&#9829;":           {9829, 7, true},
+		"&#x2665;":          {0x2665, 8, true},
+		"&#X2665 x":         {0x2665, 7, false},
+		"&#65a":             {65, 4, false},
+		"&#99999999999999;": {0x110000, 17, true},
+		"&#":                {0, 0, false},
+		"&#x;":              {0, 0, false},
+		"&#;":               {0, 0, false},
+		"&amp;":             {0, 0, false},
+		"#65;":              {0, 0, false},
+	}
+
+	for input, expected := range cases {
+		value, n, semicolon := ParseNumeric(input)
+		if actual := (result{value, n, semicolon}); actual != expected {
+			t.Errorf("ParseNumeric(%q) is %v, but expected %v.", input, actual, expected)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+
+	cases := map[rune]rune{
+		'A':      'A',
+		0:        '�',
+		0xD800:   '�',
+		0x110000: '�',
+		0x80:     '€',
+		0x81:     '\u0081',
+		0x9F:     'Ÿ',
+		0xFFFF:   0xFFFF,
+	}
+
+	for input, expected := range cases {
+		if actual := Decode(input); actual != expected {
+			t.Errorf("Decode(%#x) is %q, but expected %q.", input, actual, expected)
+		}
+	}
+}
+
+func TestKeepsLegacyReference(t *testing.T) {
+	for _, c := range []byte("=azAZ09") {
+		if !KeepsLegacyReference(c) {
+			t.Errorf("KeepsLegacyReference(%q) is false, but expected true.", c)
+		}
+	}
+	for _, c := range []byte(" ;&#-_") {
+		if KeepsLegacyReference(c) {
+			t.Errorf("KeepsLegacyReference(%q) is true, but expected false.", c)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Package namehash is the perfect hash of the character reference names, for
+// the checker package and for gen_characternames.go, which builds the table
+// that the checker looks names up in. Both must hash names the same way, so
+// the hash is only written down here.
+package namehash
+
+import (
+	"math/bits"
+)
+
+// Hash returns the hash of name.
+//
+func Hash(name string) uint64 {
+	var h uint64
+	for i := 0; i < len(name); i++ {
+		h = Next(h, name[i])
+	}
+	return h
+}
+
+// Next returns the hash of a name that is the name with hash h followed by the
+// byte c. Because the hash takes one byte at a time, the hashes of all of the
+// prefixes of a string fall out of hashing the string once.
+//
+func Next(h uint64, c byte) uint64 {
+	return bits.RotateLeft64(h, 7) ^ uint64(c)
+}
+
+// Bucket returns the bucket of a name with hash h, out of numBuckets, which
+// must be a power of two.
+//
+func Bucket(h uint64, numBuckets int) int {
+	return int((h*0x9E3779B97F4A7C15)>>32) & (numBuckets - 1)
+}
+
+// Slot returns the slot of a name with hash h, out of numSlots, given the seed
+// of its bucket.
+//
+func Slot(h uint64, seed uint32, numSlots int) int {
+	x := (h ^ uint64(seed)) * 0xFF51AFD7ED558CCD
+	return int((x >> 32) * uint64(numSlots) >> 32)
+}
@@ -0,0 +1,28 @@
+package namehash
+
+import (
+	"testing"
+)
+
+func TestHash_prefixes(t *testing.T) {
+	name := "CounterClockwiseContourIntegral"
+	var h uint64
+	for i := 0; i < len(name); i++ {
+		h = Next(h, name[i])
+		if expected := Hash(name[:i+1]); h != expected {
+			t.Errorf("Next gives %#x for %q, but Hash gives %#x.", h, name[:i+1], expected)
+		}
+	}
+}
+
+func TestBucketAndSlot_inRange(t *testing.T) {
+	for _, name := range []string{"", "amp", "nbsp", "tuesday", "CounterClockwiseContourIntegral"} {
+		h := Hash(name)
+		if b := Bucket(h, 1024); b < 0 || b >= 1024 {
+			t.Errorf("Bucket(Hash(%q), 1024) is %d, which is out of range.", name, b)
+		}
+		if s := Slot(h, 12345, 2125); s < 0 || s >= 2125 {
+			t.Errorf("Slot(Hash(%q), 12345, 2125) is %d, which is out of range.", name, s)
+		}
+	}
+}
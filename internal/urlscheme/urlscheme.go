@@ -0,0 +1,60 @@
+// Package urlscheme finds the scheme of a URL, and says which schemes and
+// characters are safe, for the checker and escaper packages.
+package urlscheme
+
+import (
+	"strings"
+)
+
+// Safe are the schemes that escaper.DefaultURLPolicy allows, and that
+// checker.IsValid allows in the URL context: web links, email addresses, and
+// phone numbers.
+//
+var Safe = [...]string{"http", "https", "mailto", "tel"}
+
+// Characters are the ASCII characters that are safe in a URL attribute as they
+// are: the unreserved and reserved characters of RFC 3986, and "%" for
+// escapes. The apostrophe is left out, so URLs are safe in single quotes.
+//
+const Characters = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789" +
+	"-._~:/?#[]@!$&()*+,;=%"
+
+// IsSafe returns true if the scheme is one of the Safe schemes, in any case.
+//
+func IsSafe(scheme string) bool {
+	for _, safe := range Safe {
+		if strings.EqualFold(scheme, safe) {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse returns the scheme of the URL and the part after the colon. If the URL
+// doesn't start with a scheme, ok is false, and the URL is relative. The URL
+// must already have its character references decoded, and its tabs and
+// newlines removed.
+//
+//     A URL-scheme string must be one ASCII alpha, followed by zero or more of
+//     ASCII alphanumeric, U+002B (+), U+002D (-), and U+002E (.).
+//
+// From https://url.spec.whatwg.org/#url-scheme-string
+//
+func Parse(url string) (scheme, rest string, ok bool) {
+
+	i := strings.IndexByte(url, ':')
+	if i < 1 {
+		return "", "", false
+	}
+
+	scheme = url[:i]
+	for j := 0; j < len(scheme); j++ {
+		c := scheme[j]
+		isAlpha := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		isDigit := c >= '0' && c <= '9'
+		if !isAlpha && (j == 0 || !(isDigit || c == '+' || c == '-' || c == '.')) {
+			return "", "", false
+		}
+	}
+	return scheme, url[i+1:], true
+}
@@ -0,0 +1,40 @@
+package urlscheme
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+
+	cases := map[string][2]string{
+		"https://example.com": {"https", "//example.com"},
+		"MailTo:a@b":          {"MailTo", "a@b"},
+		"x-y.z+w:q":           {"x-y.z+w", "q"},
+	}
+
+	for input, expected := range cases {
+		scheme, rest, ok := Parse(input)
+		if !ok || scheme != expected[0] || rest != expected[1] {
+			t.Errorf("Parse(%q) is %q, %q, %v, but expected %q, %q, true.", input, scheme, rest, ok, expected[0], expected[1])
+		}
+	}
+
+	for _, input := range []string{"", "/a:b", ":x", "1a:b", "a b:c", "a/b:c"} {
+		if _, _, ok := Parse(input); ok {
+			t.Errorf("Expecting %q to have no scheme, but got one.", input)
+		}
+	}
+}
+
+func TestIsSafe(t *testing.T) {
+	for _, scheme := range []string{"http", "HTTPS", "mailto", "Tel"} {
+		if !IsSafe(scheme) {
+			t.Errorf("Expecting %q to be safe, but got false.", scheme)
+		}
+	}
+	for _, scheme := range []string{"", "javascript", "data", "vbscript", "ftp", "https:"} {
+		if IsSafe(scheme) {
+			t.Errorf("Expecting %q to NOT be safe, but got true.", scheme)
+		}
+	}
+}